@@ -38,10 +38,12 @@ type APIKeySpec struct {
 	// Specifies whether (true) or not (false) the key identifier is distinct from
 	// the created API key value. This parameter is deprecated and should not be
 	// used.
-	GenerateDistinctID *bool `json:"generateDistinctID,omitempty"`
+	GenerateDistinctID   *bool              `json:"generateDistinctID,omitempty"`
+	GeneratedValueSecret *APIKeyValueSecret `json:"generatedValueSecret,omitempty"`
 	// The name of the ApiKey.
 	// +kubebuilder:validation:Required
-	Name *string `json:"name"`
+	Name     *string         `json:"name"`
+	Rotation *APIKeyRotation `json:"rotation,omitempty"`
 	// DEPRECATED FOR USAGE PLANS - Specifies stages associated with the API key.
 	StageKeys []*StageKey `json:"stageKeys,omitempty"`
 	// The key-value map of strings. The valid character set is [a-zA-Z+-=._:/].
@@ -74,6 +76,8 @@ type APIKeyStatus struct {
 	// The timestamp when the API Key was last updated.
 	// +kubebuilder:validation:Optional
 	LastUpdatedDate *metav1.Time `json:"lastUpdatedDate,omitempty"`
+	// +kubebuilder:validation:Optional
+	RotationStatus *APIKeyRotationStatus `json:"rotationStatus,omitempty"`
 }
 
 // APIKey is the Schema for the APIKeys API
@@ -0,0 +1,25 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package v1alpha1
+
+// APIKeyImportPendingBatch is the batch of rows of the CSV file of an
+// APIKeyImport that an ImportApiKeys call is importing. It is recorded before
+// the call and cleared along with the result, so that a batch whose result
+// was not recorded is reconciled before the next one is imported.
+type APIKeyImportPendingBatch struct {
+	// The index of the first row of the batch, header excluded, starting at 0.
+	First *int64 `json:"first"`
+	// The number of rows of the batch.
+	Size *int64 `json:"size"`
+}
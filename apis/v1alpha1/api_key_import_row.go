@@ -0,0 +1,23 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package v1alpha1
+
+// APIKeyImportRow is a row of the CSV file of an APIKeyImport that API Gateway
+// skipped with a warning.
+type APIKeyImportRow struct {
+	// The number of the row in the CSV file, header excluded, starting at 1.
+	Row *int64 `json:"row"`
+	// The name of the API key of the row.
+	Name *string `json:"name,omitempty"`
+}
@@ -0,0 +1,50 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// APIKeyRotation configures the rotation of an API key. A rotation creates a
+// new key, with the same name, stage keys and usage plans, which replaces the
+// previous key in the status and in the generated value Secret. The previous
+// key keeps working for the overlap period, after which it is disabled and
+// deleted. The value of the key must be generated by API Gateway, spec.value
+// cannot be set.
+//
+// The key is rotated when the interval has elapsed since the last rotation,
+// or when the value of the apigateway.services.k8s.aws/rotate annotation
+// changes. Both are checked whenever the APIKey is reconciled, so rotations
+// and deletions are late by up to the resync period of the APIKeys.
+type APIKeyRotation struct {
+	// The interval between two rotations, e.g. "2160h" for 90 days. The key is
+	// only rotated on request when unset.
+	Interval *metav1.Duration `json:"interval,omitempty"`
+	// How long the previous key keeps working after a rotation. Defaults to
+	// "24h".
+	Overlap *metav1.Duration `json:"overlap,omitempty"`
+}
+
+// APIKeyRotationStatus is the observed state of the rotation of an API key.
+type APIKeyRotationStatus struct {
+	// The time of the last rotation, or of the creation of the key.
+	LastRotationTime *metav1.Time `json:"lastRotationTime,omitempty"`
+	// The value of the rotate annotation the last rotation was requested with.
+	LastRequest *string `json:"lastRequest,omitempty"`
+	// The identifier of the previous API key, until it is deleted.
+	PreviousID *string `json:"previousID,omitempty"`
+	// The time the previous API key is disabled and deleted at.
+	PreviousExpirationTime *metav1.Time `json:"previousExpirationTime,omitempty"`
+}
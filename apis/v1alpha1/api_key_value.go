@@ -0,0 +1,25 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package v1alpha1
+
+// APIKeyValueSecret is the Secret the value API Gateway generated for an API
+// key is written to, in the namespace of the APIKey. The Secret is created by
+// the controller and owned by the APIKey. It cannot be used with spec.value.
+type APIKeyValueSecret struct {
+	// The name of the Secret.
+	// +kubebuilder:validation:Required
+	Name *string `json:"name"`
+	// The key of the value in the Secret. Defaults to "value".
+	Key *string `json:"key,omitempty"`
+}
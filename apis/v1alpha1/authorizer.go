@@ -81,6 +81,9 @@ type AuthorizerSpec struct {
 	// Each element is of this format: arn:aws:cognito-idp:{region}:{account_id}:userpool/{user_pool_id}.
 	// For a TOKEN or REQUEST authorizer, this is not defined.
 	ProviderARNs []*string `json:"providerARNs,omitempty"`
+	// Reference field for ProviderARNs. Each reference points at an ACK Cognito
+	// UserPool resource whose ARN is added to ProviderARNs.
+	ProviderRefs []*ackv1alpha1.AWSResourceReferenceWrapper `json:"providerRefs,omitempty"`
 	// The string identifier of the associated RestApi.
 	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable once set"
 	RestAPIID  *string                                  `json:"restAPIID,omitempty"`
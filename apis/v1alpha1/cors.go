@@ -0,0 +1,42 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package v1alpha1
+
+// CORSConfiguration configures the OPTIONS method that the controller creates
+// on a Resource to answer CORS preflight requests, and the
+// Access-Control-Allow-Origin header returned by the other methods of the
+// Resource.
+//
+// +kubebuilder:validation:XValidation:rule="!has(self.allowCredentials) || !self.allowCredentials || !self.allowOrigins.exists(o, o == '*')",message="allowCredentials cannot be used with the * origin"
+type CORSConfiguration struct {
+	// The origins allowed to call the resource, or * for any origin. When
+	// more than one origin is allowed the preflight response echoes the
+	// Origin of the request if it is allowed, and the other methods of the
+	// resource must return the Access-Control-Allow-Origin header themselves.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinItems=1
+	AllowOrigins []*string `json:"allowOrigins"`
+	// The methods allowed in cross-origin requests. Defaults to the methods of
+	// the resource.
+	AllowMethods []*string `json:"allowMethods,omitempty"`
+	// The request headers allowed in cross-origin requests. Defaults to
+	// Content-Type, X-Amz-Date, Authorization, X-Api-Key and
+	// X-Amz-Security-Token.
+	AllowHeaders []*string `json:"allowHeaders,omitempty"`
+	// The number of seconds browsers may cache the preflight response.
+	// +kubebuilder:validation:Minimum=0
+	MaxAge *int64 `json:"maxAge,omitempty"`
+	// Whether cross-origin requests may include credentials.
+	AllowCredentials *bool `json:"allowCredentials,omitempty"`
+}
@@ -63,6 +63,7 @@ type IntegrationSpec struct {
 	// requestTemplates property on the Integration resource. There are three valid
 	// values: WHEN_NO_MATCH, WHEN_NO_TEMPLATES, and NEVER.
 	PassthroughBehavior *string `json:"passthroughBehavior,omitempty"`
+	ReplacementPolicy   *string `json:"replacementPolicy,omitempty"`
 	// A key-value map specifying request parameters that are passed from the method
 	// request to the back end. The key is an integration request parameter name
 	// and the associated value is a method request parameter value or static value
@@ -84,12 +85,12 @@ type IntegrationSpec struct {
 	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable once set"
 	RestAPIID  *string                                  `json:"restAPIID,omitempty"`
 	RestAPIRef *ackv1alpha1.AWSResourceReferenceWrapper `json:"restAPIRef,omitempty"`
+	ServiceRef *IntegrationServiceReference             `json:"serviceRef,omitempty"`
 	// Custom timeout between 50 and 29,000 milliseconds. The default value is 29,000
 	// milliseconds or 29 seconds.
 	TimeoutInMillis *int64     `json:"timeoutInMillis,omitempty"`
 	TLSConfig       *TLSConfig `json:"tlsConfig,omitempty"`
 	// Specifies a put integration input's type.
-	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable once set"
 	// +kubebuilder:validation:Required
 	Type *string `json:"type,omitempty"`
 	// Specifies Uniform Resource Identifier (URI) of the integration endpoint.
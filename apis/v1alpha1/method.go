@@ -53,7 +53,8 @@ type MethodSpec struct {
 	// Specifies the identifier of an Authorizer to use on this Method, if the type
 	// is CUSTOM or COGNITO_USER_POOLS. The authorizer identifier is generated by
 	// API Gateway when you created the authorizer.
-	AuthorizerID *string `json:"authorizerID,omitempty"`
+	AuthorizerID  *string                                  `json:"authorizerID,omitempty"`
+	AuthorizerRef *ackv1alpha1.AWSResourceReferenceWrapper `json:"authorizerRef,omitempty"`
 	// Specifies the method request's HTTP method type.
 	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable once set"
 	// +kubebuilder:validation:Required
@@ -76,7 +77,8 @@ type MethodSpec struct {
 	// integration request parameters or body-mapping templates.
 	RequestParameters map[string]*bool `json:"requestParameters,omitempty"`
 	// The identifier of a RequestValidator for validating the method request.
-	RequestValidatorID *string `json:"requestValidatorID,omitempty"`
+	RequestValidatorID  *string                                  `json:"requestValidatorID,omitempty"`
+	RequestValidatorRef *ackv1alpha1.AWSResourceReferenceWrapper `json:"requestValidatorRef,omitempty"`
 	// The Resource identifier for the new Method resource.
 	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable once set"
 	ResourceID  *string                                  `json:"resourceID,omitempty"`
@@ -0,0 +1,49 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package v1alpha1
+
+// RestAPIPolicySpec describes the resource policy of a REST API, which the
+// controller renders into an IAM policy document allowing execute-api:Invoke
+// on the REST API. Each source restriction that is set denies the requests
+// that do not match it, so a request must match all of them.
+type RestAPIPolicySpec struct {
+	// The IDs of the VPC endpoints allowed to invoke the REST API.
+	SourceVPCEndpointIDs []*string `json:"sourceVPCEndpointIDs,omitempty"`
+	// Whether the VPC endpoints of spec.endpointConfiguration, including the
+	// ones resolved from vpcEndpointRefs, are allowed to invoke the REST API,
+	// in addition to sourceVPCEndpointIDs.
+	AllowEndpointConfigurationVPCEndpoints *bool `json:"allowEndpointConfigurationVPCEndpoints,omitempty"`
+	// The source IP ranges, in CIDR notation, allowed to invoke the REST API.
+	// For a private REST API they are matched against the IP addresses in the
+	// VPC (aws:VpcSourceIp).
+	SourceIPs []*string `json:"sourceIPs,omitempty"`
+	// The AWS principals, account IDs or IAM ARNs, allowed to invoke the REST
+	// API. Any principal is allowed when unset.
+	Principals []*string `json:"principals,omitempty"`
+	// Invocations explicitly denied, whatever the sources allowed.
+	Denies []*RestAPIPolicyDeny `json:"denies,omitempty"`
+}
+
+// RestAPIPolicyDeny denies the invocation of methods of a REST API. The
+// conditions that are set must all match for the deny to apply.
+type RestAPIPolicyDeny struct {
+	// The methods denied, as {stage}/{httpMethod}/{resourcePath} patterns
+	// relative to the REST API, e.g. "prod/DELETE/*". All methods when unset.
+	Resources []*string `json:"resources,omitempty"`
+	// The AWS principals, account IDs or IAM ARNs, denied. Any principal when
+	// unset.
+	Principals []*string `json:"principals,omitempty"`
+	// The source IP ranges, in CIDR notation, denied. Any source when unset.
+	SourceIPs []*string `json:"sourceIPs,omitempty"`
+}
@@ -0,0 +1,71 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package v1alpha1
+
+import (
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+)
+
+// ServiceReference identifies a Kubernetes Service.
+type ServiceReference struct {
+	// The name of the Service.
+	// +kubebuilder:validation:Required
+	Name *string `json:"name"`
+	// The namespace of the Service. Defaults to the namespace of the
+	// referencing resource.
+	Namespace *string `json:"namespace,omitempty"`
+}
+
+// IntegrationServiceReference identifies the port and path of a Kubernetes
+// Service that an HTTP or HTTP_PROXY integration sends requests to through a
+// VPC link. The controller sets the uri of the integration to the DNS name of
+// the network load balancer of the Service and sets its connectionType to
+// VPC_LINK. The integration must reference the VPCLink targeting the Service
+// from connectionRef.
+type IntegrationServiceReference struct {
+	// The name of the Service. The Service must be of type LoadBalancer and be
+	// provisioned as a network load balancer by the AWS Load Balancer
+	// Controller.
+	// +kubebuilder:validation:Required
+	Name *string `json:"name"`
+	// The namespace of the Service. Defaults to the namespace of the
+	// referencing resource.
+	Namespace *string `json:"namespace,omitempty"`
+	// The path appended to the load balancer URI, e.g. /orders/{proxy}.
+	// Defaults to /.
+	// +kubebuilder:validation:Pattern=`^/`
+	Path *string `json:"path,omitempty"`
+	// The Service port that requests are sent to.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	Port *int64 `json:"port"`
+	// The scheme used to reach the load balancer. Defaults to http.
+	// +kubebuilder:validation:Enum=http;https
+	Scheme *string `json:"scheme,omitempty"`
+}
+
+// VPCLinkTargetReference identifies the network load balancer targeted by a
+// VPC link. Exactly one of LoadBalancerRef or ServiceRef must be set.
+// +kubebuilder:validation:XValidation:rule="has(self.loadBalancerRef) != has(self.serviceRef)",message="exactly one of loadBalancerRef or serviceRef must be set"
+type VPCLinkTargetReference struct {
+	// Reference to an ACK ELBv2 LoadBalancer resource. The ARN of the load
+	// balancer is read from its Status.ACKResourceMetadata.ARN field.
+	LoadBalancerRef *ackv1alpha1.AWSResourceReferenceWrapper `json:"loadBalancerRef,omitempty"`
+	// Reference to a Kubernetes Service of type LoadBalancer that is
+	// provisioned as a network load balancer by the AWS Load Balancer
+	// Controller. The ARN of the load balancer is discovered from the
+	// Service once its status reports the load balancer hostname.
+	ServiceRef *ServiceReference `json:"serviceRef,omitempty"`
+}
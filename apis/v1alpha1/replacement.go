@@ -0,0 +1,24 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package v1alpha1
+
+// ReplacementPolicy controls how the controller handles changes to fields that
+// API Gateway cannot update in place.
+type ReplacementPolicy string
+
+const (
+	// ReplacementPolicy_Recreate deletes and recreates the AWS resource when
+	// a field that cannot be updated in place is changed.
+	ReplacementPolicy_Recreate ReplacementPolicy = "Recreate"
+)
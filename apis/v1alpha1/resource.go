@@ -24,7 +24,7 @@ import (
 //
 // Represents an API resource.
 type ResourceSpec struct {
-
+	CORS *CORSConfiguration `json:"cors,omitempty"`
 	// The parent resource's identifier.
 	ParentID  *string                                  `json:"parentID,omitempty"`
 	ParentRef *ackv1alpha1.AWSResourceReferenceWrapper `json:"parentRef,omitempty"`
@@ -34,7 +34,6 @@ type RestAPISpec struct {
 	// RestApi supports only UTF-8-encoded text payloads.
 	BinaryMediaTypes []*string `json:"binaryMediaTypes,omitempty"`
 	// The ID of the RestApi that you want to clone from.
-	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable once set"
 	CloneFrom *string `json:"cloneFrom,omitempty"`
 	// The description of the RestApi.
 	Description *string `json:"description,omitempty"`
@@ -57,13 +56,14 @@ type RestAPISpec struct {
 	Name *string `json:"name"`
 	// A stringified JSON policy document that applies to this RestApi regardless
 	// of the caller and Method configuration.
-	Policy *string `json:"policy,omitempty"`
+	Policy            *string            `json:"policy,omitempty"`
+	PolicySpec        *RestAPIPolicySpec `json:"policySpec,omitempty"`
+	ReplacementPolicy *string            `json:"replacementPolicy,omitempty"`
 	// The key-value map of strings. The valid character set is [a-zA-Z+-=._:/].
 	// The tag key can be up to 128 characters and must not start with aws:. The
 	// tag value can be up to 256 characters.
 	Tags map[string]*string `json:"tags,omitempty"`
 	// A version identifier for the API.
-	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable once set"
 	Version *string `json:"version,omitempty"`
 }
 
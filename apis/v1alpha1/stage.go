@@ -39,14 +39,15 @@ type StageSpec struct {
 	// The description of the Stage resource.
 	Description *string `json:"description,omitempty"`
 	// The version of the associated API documentation.
-	DocumentationVersion *string `json:"documentationVersion,omitempty"`
+	DocumentationVersion    *string                                  `json:"documentationVersion,omitempty"`
+	DocumentationVersionRef *ackv1alpha1.AWSResourceReferenceWrapper `json:"documentationVersionRef,omitempty"`
+	ReplacementPolicy       *string                                  `json:"replacementPolicy,omitempty"`
 	// The string identifier of the associated RestApi.
 	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable once set"
 	RestAPIID  *string                                  `json:"restAPIID,omitempty"`
 	RestAPIRef *ackv1alpha1.AWSResourceReferenceWrapper `json:"restAPIRef,omitempty"`
 	// The name for the Stage resource. Stage names can only contain alphanumeric
 	// characters, hyphens, and underscores. Maximum length is 128 characters.
-	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable once set"
 	// +kubebuilder:validation:Required
 	StageName *string `json:"stageName"`
 	// The key-value map of strings. The valid character set is [a-zA-Z+-=._:/].
@@ -122,20 +122,24 @@ type Deployment_SDK struct {
 }
 
 // A documentation part for a targeted API entity.
-type DocumentationPart struct {
-	ID         *string `json:"id,omitempty"`
-	Properties *string `json:"properties,omitempty"`
+type DocumentationPart_SDK struct {
+	ID *string `json:"id,omitempty"`
+	// Specifies the target API entity to which the documentation applies.
+	Location   *DocumentationPartLocation `json:"location,omitempty"`
+	Properties *string                    `json:"properties,omitempty"`
 }
 
 // Specifies the target API entity to which the documentation applies.
 type DocumentationPartLocation struct {
-	Method *string `json:"method,omitempty"`
-	Name   *string `json:"name,omitempty"`
-	Path   *string `json:"path,omitempty"`
+	Method     *string `json:"method,omitempty"`
+	Name       *string `json:"name,omitempty"`
+	Path       *string `json:"path,omitempty"`
+	StatusCode *string `json:"statusCode,omitempty"`
+	Type       *string `json:"type,omitempty"`
 }
 
 // A snapshot of the documentation of an API.
-type DocumentationVersion struct {
+type DocumentationVersion_SDK struct {
 	CreatedDate *metav1.Time `json:"createdDate,omitempty"`
 	Description *string      `json:"description,omitempty"`
 	Version     *string      `json:"version,omitempty"`
@@ -278,7 +282,7 @@ type Method_SDK struct {
 }
 
 // Represents the data structure of a method's request or response payload.
-type Model struct {
+type Model_SDK struct {
 	ContentType *string `json:"contentType,omitempty"`
 	Description *string `json:"description,omitempty"`
 	ID          *string `json:"id,omitempty"`
@@ -320,6 +324,14 @@ type QuotaSettings struct {
 	Offset *int64 `json:"offset,omitempty"`
 }
 
+// A set of validation rules for incoming Method requests.
+type RequestValidator_SDK struct {
+	ID                        *string `json:"id,omitempty"`
+	Name                      *string `json:"name,omitempty"`
+	ValidateRequestBody       *bool   `json:"validateRequestBody,omitempty"`
+	ValidateRequestParameters *bool   `json:"validateRequestParameters,omitempty"`
+}
+
 // Represents an API resource.
 type Resource_SDK struct {
 	ID       *string `json:"id,omitempty"`
@@ -367,7 +379,11 @@ type SDKType struct {
 // A reference to a unique stage identified in the format {restApiId}/{stage}.
 type StageKey struct {
 	RestAPIID *string `json:"restAPIID,omitempty"`
-	StageName *string `json:"stageName,omitempty"`
+	// Reference field for RestAPIID
+	RestAPIRef *ackv1alpha1.AWSResourceReferenceWrapper `json:"restAPIRef,omitempty"`
+	StageName  *string                                  `json:"stageName,omitempty"`
+	// Reference field for StageName
+	StageRef *ackv1alpha1.AWSResourceReferenceWrapper `json:"stageRef,omitempty"`
 }
 
 // Represents a unique identifier for a version of a deployed RestApi that is
@@ -30,7 +30,8 @@ type VPCLinkSpec struct {
 	Description *string `json:"description,omitempty"`
 	// The name used to label and identify the VPC link.
 	// +kubebuilder:validation:Required
-	Name *string `json:"name"`
+	Name              *string `json:"name"`
+	ReplacementPolicy *string `json:"replacementPolicy,omitempty"`
 	// The key-value map of strings. The valid character set is [a-zA-Z+-=._:/].
 	// The tag key can be up to 128 characters and must not start with aws:. The
 	// tag value can be up to 256 characters.
@@ -38,9 +39,8 @@ type VPCLinkSpec struct {
 	// The ARN of the network load balancer of the VPC targeted by the VPC link.
 	// The network load balancer must be owned by the same Amazon Web Services account
 	// of the API owner.
-	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable once set"
-	// +kubebuilder:validation:Required
-	TargetARNs []*string `json:"targetARNs"`
+	TargetARNs []*string                 `json:"targetARNs,omitempty"`
+	TargetRefs []*VPCLinkTargetReference `json:"targetRefs,omitempty"`
 }
 
 // VPCLinkStatus defines the observed state of VPCLink
@@ -60,6 +60,8 @@ type VPCLinkStatus struct {
 	// this VpcLink.
 	// +kubebuilder:validation:Optional
 	ID *string `json:"id,omitempty"`
+	// +kubebuilder:validation:Optional
+	ReplacedID *string `json:"replacedID,omitempty"`
 	// The status of the VPC link. The valid values are AVAILABLE, PENDING, DELETING,
 	// or FAILED. Deploying an API will wait if the status is PENDING and will fail
 	// if the status is DELETING.
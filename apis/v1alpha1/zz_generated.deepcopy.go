@@ -21,6 +21,7 @@ package v1alpha1
 
 import (
 	corev1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -228,6 +229,229 @@ func (in *APIKey) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *APIKeyImport) DeepCopyInto(out *APIKeyImport) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APIKeyImport.
+func (in *APIKeyImport) DeepCopy() *APIKeyImport {
+	if in == nil {
+		return nil
+	}
+	out := new(APIKeyImport)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *APIKeyImport) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *APIKeyImportList) DeepCopyInto(out *APIKeyImportList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]APIKeyImport, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APIKeyImportList.
+func (in *APIKeyImportList) DeepCopy() *APIKeyImportList {
+	if in == nil {
+		return nil
+	}
+	out := new(APIKeyImportList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *APIKeyImportList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *APIKeyImportPendingBatch) DeepCopyInto(out *APIKeyImportPendingBatch) {
+	*out = *in
+	if in.First != nil {
+		in, out := &in.First, &out.First
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Size != nil {
+		in, out := &in.Size, &out.Size
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APIKeyImportPendingBatch.
+func (in *APIKeyImportPendingBatch) DeepCopy() *APIKeyImportPendingBatch {
+	if in == nil {
+		return nil
+	}
+	out := new(APIKeyImportPendingBatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *APIKeyImportRow) DeepCopyInto(out *APIKeyImportRow) {
+	*out = *in
+	if in.Row != nil {
+		in, out := &in.Row, &out.Row
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APIKeyImportRow.
+func (in *APIKeyImportRow) DeepCopy() *APIKeyImportRow {
+	if in == nil {
+		return nil
+	}
+	out := new(APIKeyImportRow)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *APIKeyImportSpec) DeepCopyInto(out *APIKeyImportSpec) {
+	*out = *in
+	if in.BatchSize != nil {
+		in, out := &in.BatchSize, &out.BatchSize
+		*out = new(int64)
+		**out = **in
+	}
+	if in.CSV != nil {
+		in, out := &in.CSV, &out.CSV
+		*out = new(corev1alpha1.SecretKeyReference)
+		**out = **in
+	}
+	if in.FailOnWarnings != nil {
+		in, out := &in.FailOnWarnings, &out.FailOnWarnings
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APIKeyImportSpec.
+func (in *APIKeyImportSpec) DeepCopy() *APIKeyImportSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(APIKeyImportSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *APIKeyImportStatus) DeepCopyInto(out *APIKeyImportStatus) {
+	*out = *in
+	if in.ACKResourceMetadata != nil {
+		in, out := &in.ACKResourceMetadata, &out.ACKResourceMetadata
+		*out = new(corev1alpha1.ResourceMetadata)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]*corev1alpha1.Condition, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(corev1alpha1.Condition)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.FailedRows != nil {
+		in, out := &in.FailedRows, &out.FailedRows
+		*out = make([]*APIKeyImportRow, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(APIKeyImportRow)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.IDs != nil {
+		in, out := &in.IDs, &out.IDs
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+	if in.ImportedRows != nil {
+		in, out := &in.ImportedRows, &out.ImportedRows
+		*out = new(int64)
+		**out = **in
+	}
+	if in.ImportStatus != nil {
+		in, out := &in.ImportStatus, &out.ImportStatus
+		*out = new(string)
+		**out = **in
+	}
+	if in.PendingBatch != nil {
+		in, out := &in.PendingBatch, &out.PendingBatch
+		*out = new(APIKeyImportPendingBatch)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TotalRows != nil {
+		in, out := &in.TotalRows, &out.TotalRows
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Warnings != nil {
+		in, out := &in.Warnings, &out.Warnings
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APIKeyImportStatus.
+func (in *APIKeyImportStatus) DeepCopy() *APIKeyImportStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(APIKeyImportStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *APIKeyList) DeepCopyInto(out *APIKeyList) {
 	*out = *in
@@ -260,6 +484,64 @@ func (in *APIKeyList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *APIKeyRotation) DeepCopyInto(out *APIKeyRotation) {
+	*out = *in
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Overlap != nil {
+		in, out := &in.Overlap, &out.Overlap
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APIKeyRotation.
+func (in *APIKeyRotation) DeepCopy() *APIKeyRotation {
+	if in == nil {
+		return nil
+	}
+	out := new(APIKeyRotation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *APIKeyRotationStatus) DeepCopyInto(out *APIKeyRotationStatus) {
+	*out = *in
+	if in.LastRotationTime != nil {
+		in, out := &in.LastRotationTime, &out.LastRotationTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastRequest != nil {
+		in, out := &in.LastRequest, &out.LastRequest
+		*out = new(string)
+		**out = **in
+	}
+	if in.PreviousID != nil {
+		in, out := &in.PreviousID, &out.PreviousID
+		*out = new(string)
+		**out = **in
+	}
+	if in.PreviousExpirationTime != nil {
+		in, out := &in.PreviousExpirationTime, &out.PreviousExpirationTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APIKeyRotationStatus.
+func (in *APIKeyRotationStatus) DeepCopy() *APIKeyRotationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(APIKeyRotationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *APIKeySpec) DeepCopyInto(out *APIKeySpec) {
 	*out = *in
@@ -283,11 +565,21 @@ func (in *APIKeySpec) DeepCopyInto(out *APIKeySpec) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.GeneratedValueSecret != nil {
+		in, out := &in.GeneratedValueSecret, &out.GeneratedValueSecret
+		*out = new(APIKeyValueSecret)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Name != nil {
 		in, out := &in.Name, &out.Name
 		*out = new(string)
 		**out = **in
 	}
+	if in.Rotation != nil {
+		in, out := &in.Rotation, &out.Rotation
+		*out = new(APIKeyRotation)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.StageKeys != nil {
 		in, out := &in.StageKeys, &out.StageKeys
 		*out = make([]*StageKey, len(*in))
@@ -364,6 +656,11 @@ func (in *APIKeyStatus) DeepCopyInto(out *APIKeyStatus) {
 		in, out := &in.LastUpdatedDate, &out.LastUpdatedDate
 		*out = (*in).DeepCopy()
 	}
+	if in.RotationStatus != nil {
+		in, out := &in.RotationStatus, &out.RotationStatus
+		*out = new(APIKeyRotationStatus)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APIKeyStatus.
@@ -376,6 +673,31 @@ func (in *APIKeyStatus) DeepCopy() *APIKeyStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *APIKeyValueSecret) DeepCopyInto(out *APIKeyValueSecret) {
+	*out = *in
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.Key != nil {
+		in, out := &in.Key, &out.Key
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APIKeyValueSecret.
+func (in *APIKeyValueSecret) DeepCopy() *APIKeyValueSecret {
+	if in == nil {
+		return nil
+	}
+	out := new(APIKeyValueSecret)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *APIKey_SDK) DeepCopyInto(out *APIKey_SDK) {
 	*out = *in
@@ -674,7 +996,7 @@ func (in *AccessLogSettings) DeepCopy() *AccessLogSettings {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Authorizer) DeepCopyInto(out *Authorizer) {
+func (in *Account) DeepCopyInto(out *Account) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -682,18 +1004,18 @@ func (in *Authorizer) DeepCopyInto(out *Authorizer) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Authorizer.
-func (in *Authorizer) DeepCopy() *Authorizer {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Account.
+func (in *Account) DeepCopy() *Account {
 	if in == nil {
 		return nil
 	}
-	out := new(Authorizer)
+	out := new(Account)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Authorizer) DeepCopyObject() runtime.Object {
+func (in *Account) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -701,31 +1023,31 @@ func (in *Authorizer) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AuthorizerList) DeepCopyInto(out *AuthorizerList) {
+func (in *AccountList) DeepCopyInto(out *AccountList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]Authorizer, len(*in))
+		*out = make([]Account, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthorizerList.
-func (in *AuthorizerList) DeepCopy() *AuthorizerList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccountList.
+func (in *AccountList) DeepCopy() *AccountList {
 	if in == nil {
 		return nil
 	}
-	out := new(AuthorizerList)
+	out := new(AccountList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *AuthorizerList) DeepCopyObject() runtime.Object {
+func (in *AccountList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -733,24 +1055,160 @@ func (in *AuthorizerList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AuthorizerSpec) DeepCopyInto(out *AuthorizerSpec) {
+func (in *AccountSpec) DeepCopyInto(out *AccountSpec) {
 	*out = *in
-	if in.AuthType != nil {
-		in, out := &in.AuthType, &out.AuthType
-		*out = new(string)
-		**out = **in
-	}
-	if in.AuthorizerCredentials != nil {
-		in, out := &in.AuthorizerCredentials, &out.AuthorizerCredentials
+	if in.CloudWatchRoleARN != nil {
+		in, out := &in.CloudWatchRoleARN, &out.CloudWatchRoleARN
 		*out = new(string)
 		**out = **in
 	}
-	if in.AuthorizerResultTTLInSeconds != nil {
-		in, out := &in.AuthorizerResultTTLInSeconds, &out.AuthorizerResultTTLInSeconds
-		*out = new(int64)
-		**out = **in
+	if in.CloudWatchRoleRef != nil {
+		in, out := &in.CloudWatchRoleRef, &out.CloudWatchRoleRef
+		*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.AuthorizerURI != nil {
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccountSpec.
+func (in *AccountSpec) DeepCopy() *AccountSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AccountSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccountStatus) DeepCopyInto(out *AccountStatus) {
+	*out = *in
+	if in.ACKResourceMetadata != nil {
+		in, out := &in.ACKResourceMetadata, &out.ACKResourceMetadata
+		*out = new(corev1alpha1.ResourceMetadata)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]*corev1alpha1.Condition, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(corev1alpha1.Condition)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.APIKeyVersion != nil {
+		in, out := &in.APIKeyVersion, &out.APIKeyVersion
+		*out = new(string)
+		**out = **in
+	}
+	if in.Features != nil {
+		in, out := &in.Features, &out.Features
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+	if in.ThrottleSettings != nil {
+		in, out := &in.ThrottleSettings, &out.ThrottleSettings
+		*out = new(ThrottleSettings)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccountStatus.
+func (in *AccountStatus) DeepCopy() *AccountStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AccountStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Authorizer) DeepCopyInto(out *Authorizer) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Authorizer.
+func (in *Authorizer) DeepCopy() *Authorizer {
+	if in == nil {
+		return nil
+	}
+	out := new(Authorizer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Authorizer) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuthorizerList) DeepCopyInto(out *AuthorizerList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Authorizer, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthorizerList.
+func (in *AuthorizerList) DeepCopy() *AuthorizerList {
+	if in == nil {
+		return nil
+	}
+	out := new(AuthorizerList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AuthorizerList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuthorizerSpec) DeepCopyInto(out *AuthorizerSpec) {
+	*out = *in
+	if in.AuthType != nil {
+		in, out := &in.AuthType, &out.AuthType
+		*out = new(string)
+		**out = **in
+	}
+	if in.AuthorizerCredentials != nil {
+		in, out := &in.AuthorizerCredentials, &out.AuthorizerCredentials
+		*out = new(string)
+		**out = **in
+	}
+	if in.AuthorizerResultTTLInSeconds != nil {
+		in, out := &in.AuthorizerResultTTLInSeconds, &out.AuthorizerResultTTLInSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	if in.AuthorizerURI != nil {
 		in, out := &in.AuthorizerURI, &out.AuthorizerURI
 		*out = new(string)
 		**out = **in
@@ -781,6 +1239,17 @@ func (in *AuthorizerSpec) DeepCopyInto(out *AuthorizerSpec) {
 			}
 		}
 	}
+	if in.ProviderRefs != nil {
+		in, out := &in.ProviderRefs, &out.ProviderRefs
+		*out = make([]*corev1alpha1.AWSResourceReferenceWrapper, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
 	if in.RestAPIID != nil {
 		in, out := &in.RestAPIID, &out.RestAPIID
 		*out = new(string)
@@ -945,6 +1414,64 @@ func (in *BasePathMapping) DeepCopy() *BasePathMapping {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CORSConfiguration) DeepCopyInto(out *CORSConfiguration) {
+	*out = *in
+	if in.AllowOrigins != nil {
+		in, out := &in.AllowOrigins, &out.AllowOrigins
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+	if in.AllowMethods != nil {
+		in, out := &in.AllowMethods, &out.AllowMethods
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+	if in.AllowHeaders != nil {
+		in, out := &in.AllowHeaders, &out.AllowHeaders
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+	if in.MaxAge != nil {
+		in, out := &in.MaxAge, &out.MaxAge
+		*out = new(int64)
+		**out = **in
+	}
+	if in.AllowCredentials != nil {
+		in, out := &in.AllowCredentials, &out.AllowCredentials
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CORSConfiguration.
+func (in *CORSConfiguration) DeepCopy() *CORSConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(CORSConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CanarySettings) DeepCopyInto(out *CanarySettings) {
 	*out = *in
@@ -1347,16 +1874,10 @@ func (in *Deployment_SDK) DeepCopy() *Deployment_SDK {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DocumentationPart) DeepCopyInto(out *DocumentationPart) {
 	*out = *in
-	if in.ID != nil {
-		in, out := &in.ID, &out.ID
-		*out = new(string)
-		**out = **in
-	}
-	if in.Properties != nil {
-		in, out := &in.Properties, &out.Properties
-		*out = new(string)
-		**out = **in
-	}
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DocumentationPart.
@@ -1369,6 +1890,46 @@ func (in *DocumentationPart) DeepCopy() *DocumentationPart {
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DocumentationPart) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DocumentationPartList) DeepCopyInto(out *DocumentationPartList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DocumentationPart, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DocumentationPartList.
+func (in *DocumentationPartList) DeepCopy() *DocumentationPartList {
+	if in == nil {
+		return nil
+	}
+	out := new(DocumentationPartList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DocumentationPartList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DocumentationPartLocation) DeepCopyInto(out *DocumentationPartLocation) {
 	*out = *in
@@ -1387,6 +1948,16 @@ func (in *DocumentationPartLocation) DeepCopyInto(out *DocumentationPartLocation
 		*out = new(string)
 		**out = **in
 	}
+	if in.StatusCode != nil {
+		in, out := &in.StatusCode, &out.StatusCode
+		*out = new(string)
+		**out = **in
+	}
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DocumentationPartLocation.
@@ -1400,22 +1971,113 @@ func (in *DocumentationPartLocation) DeepCopy() *DocumentationPartLocation {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DocumentationVersion) DeepCopyInto(out *DocumentationVersion) {
+func (in *DocumentationPartSpec) DeepCopyInto(out *DocumentationPartSpec) {
 	*out = *in
-	if in.CreatedDate != nil {
-		in, out := &in.CreatedDate, &out.CreatedDate
-		*out = (*in).DeepCopy()
+	if in.Location != nil {
+		in, out := &in.Location, &out.Location
+		*out = new(DocumentationPartLocation)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.Description != nil {
-		in, out := &in.Description, &out.Description
+	if in.Properties != nil {
+		in, out := &in.Properties, &out.Properties
 		*out = new(string)
 		**out = **in
 	}
-	if in.Version != nil {
-		in, out := &in.Version, &out.Version
+	if in.RestAPIID != nil {
+		in, out := &in.RestAPIID, &out.RestAPIID
 		*out = new(string)
 		**out = **in
 	}
+	if in.RestAPIRef != nil {
+		in, out := &in.RestAPIRef, &out.RestAPIRef
+		*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DocumentationPartSpec.
+func (in *DocumentationPartSpec) DeepCopy() *DocumentationPartSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DocumentationPartSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DocumentationPartStatus) DeepCopyInto(out *DocumentationPartStatus) {
+	*out = *in
+	if in.ACKResourceMetadata != nil {
+		in, out := &in.ACKResourceMetadata, &out.ACKResourceMetadata
+		*out = new(corev1alpha1.ResourceMetadata)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]*corev1alpha1.Condition, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(corev1alpha1.Condition)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DocumentationPartStatus.
+func (in *DocumentationPartStatus) DeepCopy() *DocumentationPartStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DocumentationPartStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DocumentationPart_SDK) DeepCopyInto(out *DocumentationPart_SDK) {
+	*out = *in
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Location != nil {
+		in, out := &in.Location, &out.Location
+		*out = new(DocumentationPartLocation)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Properties != nil {
+		in, out := &in.Properties, &out.Properties
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DocumentationPart_SDK.
+func (in *DocumentationPart_SDK) DeepCopy() *DocumentationPart_SDK {
+	if in == nil {
+		return nil
+	}
+	out := new(DocumentationPart_SDK)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DocumentationVersion) DeepCopyInto(out *DocumentationVersion) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DocumentationVersion.
@@ -1428,6 +2090,150 @@ func (in *DocumentationVersion) DeepCopy() *DocumentationVersion {
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DocumentationVersion) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DocumentationVersionList) DeepCopyInto(out *DocumentationVersionList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DocumentationVersion, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DocumentationVersionList.
+func (in *DocumentationVersionList) DeepCopy() *DocumentationVersionList {
+	if in == nil {
+		return nil
+	}
+	out := new(DocumentationVersionList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DocumentationVersionList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DocumentationVersionSpec) DeepCopyInto(out *DocumentationVersionSpec) {
+	*out = *in
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.DocumentationVersion != nil {
+		in, out := &in.DocumentationVersion, &out.DocumentationVersion
+		*out = new(string)
+		**out = **in
+	}
+	if in.RestAPIID != nil {
+		in, out := &in.RestAPIID, &out.RestAPIID
+		*out = new(string)
+		**out = **in
+	}
+	if in.RestAPIRef != nil {
+		in, out := &in.RestAPIRef, &out.RestAPIRef
+		*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.StageName != nil {
+		in, out := &in.StageName, &out.StageName
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DocumentationVersionSpec.
+func (in *DocumentationVersionSpec) DeepCopy() *DocumentationVersionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DocumentationVersionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DocumentationVersionStatus) DeepCopyInto(out *DocumentationVersionStatus) {
+	*out = *in
+	if in.ACKResourceMetadata != nil {
+		in, out := &in.ACKResourceMetadata, &out.ACKResourceMetadata
+		*out = new(corev1alpha1.ResourceMetadata)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]*corev1alpha1.Condition, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(corev1alpha1.Condition)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.CreatedDate != nil {
+		in, out := &in.CreatedDate, &out.CreatedDate
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DocumentationVersionStatus.
+func (in *DocumentationVersionStatus) DeepCopy() *DocumentationVersionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DocumentationVersionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DocumentationVersion_SDK) DeepCopyInto(out *DocumentationVersion_SDK) {
+	*out = *in
+	if in.CreatedDate != nil {
+		in, out := &in.CreatedDate, &out.CreatedDate
+		*out = (*in).DeepCopy()
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.Version != nil {
+		in, out := &in.Version, &out.Version
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DocumentationVersion_SDK.
+func (in *DocumentationVersion_SDK) DeepCopy() *DocumentationVersion_SDK {
+	if in == nil {
+		return nil
+	}
+	out := new(DocumentationVersion_SDK)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DomainName) DeepCopyInto(out *DomainName) {
 	*out = *in
@@ -1638,7 +2444,7 @@ func (in *EndpointConfiguration) DeepCopy() *EndpointConfiguration {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Integration) DeepCopyInto(out *Integration) {
+func (in *GatewayResponse) DeepCopyInto(out *GatewayResponse) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -1646,18 +2452,18 @@ func (in *Integration) DeepCopyInto(out *Integration) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Integration.
-func (in *Integration) DeepCopy() *Integration {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayResponse.
+func (in *GatewayResponse) DeepCopy() *GatewayResponse {
 	if in == nil {
 		return nil
 	}
-	out := new(Integration)
+	out := new(GatewayResponse)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Integration) DeepCopyObject() runtime.Object {
+func (in *GatewayResponse) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1665,31 +2471,31 @@ func (in *Integration) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IntegrationList) DeepCopyInto(out *IntegrationList) {
+func (in *GatewayResponseList) DeepCopyInto(out *GatewayResponseList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]Integration, len(*in))
+		*out = make([]GatewayResponse, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IntegrationList.
-func (in *IntegrationList) DeepCopy() *IntegrationList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayResponseList.
+func (in *GatewayResponseList) DeepCopy() *GatewayResponseList {
 	if in == nil {
 		return nil
 	}
-	out := new(IntegrationList)
+	out := new(GatewayResponseList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *IntegrationList) DeepCopyObject() runtime.Object {
+func (in *GatewayResponseList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1697,13 +2503,8 @@ func (in *IntegrationList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IntegrationResponse) DeepCopyInto(out *IntegrationResponse) {
+func (in *GatewayResponseSpec) DeepCopyInto(out *GatewayResponseSpec) {
 	*out = *in
-	if in.ContentHandling != nil {
-		in, out := &in.ContentHandling, &out.ContentHandling
-		*out = new(string)
-		**out = **in
-	}
 	if in.ResponseParameters != nil {
 		in, out := &in.ResponseParameters, &out.ResponseParameters
 		*out = make(map[string]*string, len(*in))
@@ -1736,11 +2537,21 @@ func (in *IntegrationResponse) DeepCopyInto(out *IntegrationResponse) {
 			(*out)[key] = outVal
 		}
 	}
-	if in.SelectionPattern != nil {
-		in, out := &in.SelectionPattern, &out.SelectionPattern
+	if in.ResponseType != nil {
+		in, out := &in.ResponseType, &out.ResponseType
+		*out = new(string)
+		**out = **in
+	}
+	if in.RestAPIID != nil {
+		in, out := &in.RestAPIID, &out.RestAPIID
 		*out = new(string)
 		**out = **in
 	}
+	if in.RestAPIRef != nil {
+		in, out := &in.RestAPIRef, &out.RestAPIRef
+		*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.StatusCode != nil {
 		in, out := &in.StatusCode, &out.StatusCode
 		*out = new(string)
@@ -1748,39 +2559,236 @@ func (in *IntegrationResponse) DeepCopyInto(out *IntegrationResponse) {
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IntegrationResponse.
-func (in *IntegrationResponse) DeepCopy() *IntegrationResponse {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayResponseSpec.
+func (in *GatewayResponseSpec) DeepCopy() *GatewayResponseSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(IntegrationResponse)
+	out := new(GatewayResponseSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IntegrationSpec) DeepCopyInto(out *IntegrationSpec) {
+func (in *GatewayResponseStatus) DeepCopyInto(out *GatewayResponseStatus) {
 	*out = *in
-	if in.CacheKeyParameters != nil {
-		in, out := &in.CacheKeyParameters, &out.CacheKeyParameters
-		*out = make([]*string, len(*in))
+	if in.ACKResourceMetadata != nil {
+		in, out := &in.ACKResourceMetadata, &out.ACKResourceMetadata
+		*out = new(corev1alpha1.ResourceMetadata)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]*corev1alpha1.Condition, len(*in))
 		for i := range *in {
 			if (*in)[i] != nil {
 				in, out := &(*in)[i], &(*out)[i]
-				*out = new(string)
-				**out = **in
+				*out = new(corev1alpha1.Condition)
+				(*in).DeepCopyInto(*out)
 			}
 		}
 	}
-	if in.CacheNamespace != nil {
-		in, out := &in.CacheNamespace, &out.CacheNamespace
-		*out = new(string)
+	if in.DefaultResponse != nil {
+		in, out := &in.DefaultResponse, &out.DefaultResponse
+		*out = new(bool)
 		**out = **in
 	}
-	if in.ConnectionID != nil {
-		in, out := &in.ConnectionID, &out.ConnectionID
-		*out = new(string)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayResponseStatus.
+func (in *GatewayResponseStatus) DeepCopy() *GatewayResponseStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(GatewayResponseStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Integration) DeepCopyInto(out *Integration) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Integration.
+func (in *Integration) DeepCopy() *Integration {
+	if in == nil {
+		return nil
+	}
+	out := new(Integration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Integration) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IntegrationList) DeepCopyInto(out *IntegrationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Integration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IntegrationList.
+func (in *IntegrationList) DeepCopy() *IntegrationList {
+	if in == nil {
+		return nil
+	}
+	out := new(IntegrationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *IntegrationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IntegrationResponse) DeepCopyInto(out *IntegrationResponse) {
+	*out = *in
+	if in.ContentHandling != nil {
+		in, out := &in.ContentHandling, &out.ContentHandling
+		*out = new(string)
+		**out = **in
+	}
+	if in.ResponseParameters != nil {
+		in, out := &in.ResponseParameters, &out.ResponseParameters
+		*out = make(map[string]*string, len(*in))
+		for key, val := range *in {
+			var outVal *string
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				inVal := (*in)[key]
+				in, out := &inVal, &outVal
+				*out = new(string)
+				**out = **in
+			}
+			(*out)[key] = outVal
+		}
+	}
+	if in.ResponseTemplates != nil {
+		in, out := &in.ResponseTemplates, &out.ResponseTemplates
+		*out = make(map[string]*string, len(*in))
+		for key, val := range *in {
+			var outVal *string
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				inVal := (*in)[key]
+				in, out := &inVal, &outVal
+				*out = new(string)
+				**out = **in
+			}
+			(*out)[key] = outVal
+		}
+	}
+	if in.SelectionPattern != nil {
+		in, out := &in.SelectionPattern, &out.SelectionPattern
+		*out = new(string)
+		**out = **in
+	}
+	if in.StatusCode != nil {
+		in, out := &in.StatusCode, &out.StatusCode
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IntegrationResponse.
+func (in *IntegrationResponse) DeepCopy() *IntegrationResponse {
+	if in == nil {
+		return nil
+	}
+	out := new(IntegrationResponse)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IntegrationServiceReference) DeepCopyInto(out *IntegrationServiceReference) {
+	*out = *in
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.Namespace != nil {
+		in, out := &in.Namespace, &out.Namespace
+		*out = new(string)
+		**out = **in
+	}
+	if in.Path != nil {
+		in, out := &in.Path, &out.Path
+		*out = new(string)
+		**out = **in
+	}
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Scheme != nil {
+		in, out := &in.Scheme, &out.Scheme
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IntegrationServiceReference.
+func (in *IntegrationServiceReference) DeepCopy() *IntegrationServiceReference {
+	if in == nil {
+		return nil
+	}
+	out := new(IntegrationServiceReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IntegrationSpec) DeepCopyInto(out *IntegrationSpec) {
+	*out = *in
+	if in.CacheKeyParameters != nil {
+		in, out := &in.CacheKeyParameters, &out.CacheKeyParameters
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+	if in.CacheNamespace != nil {
+		in, out := &in.CacheNamespace, &out.CacheNamespace
+		*out = new(string)
+		**out = **in
+	}
+	if in.ConnectionID != nil {
+		in, out := &in.ConnectionID, &out.ConnectionID
+		*out = new(string)
+		**out = **in
 	}
 	if in.ConnectionRef != nil {
 		in, out := &in.ConnectionRef, &out.ConnectionRef
@@ -1817,6 +2825,11 @@ func (in *IntegrationSpec) DeepCopyInto(out *IntegrationSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.ReplacementPolicy != nil {
+		in, out := &in.ReplacementPolicy, &out.ReplacementPolicy
+		*out = new(string)
+		**out = **in
+	}
 	if in.RequestParameters != nil {
 		in, out := &in.RequestParameters, &out.RequestParameters
 		*out = make(map[string]*string, len(*in))
@@ -1869,6 +2882,11 @@ func (in *IntegrationSpec) DeepCopyInto(out *IntegrationSpec) {
 		*out = new(corev1alpha1.AWSResourceReferenceWrapper)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ServiceRef != nil {
+		in, out := &in.ServiceRef, &out.ServiceRef
+		*out = new(IntegrationServiceReference)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.TimeoutInMillis != nil {
 		in, out := &in.TimeoutInMillis, &out.TimeoutInMillis
 		*out = new(int64)
@@ -2307,6 +3325,11 @@ func (in *MethodSpec) DeepCopyInto(out *MethodSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.AuthorizerRef != nil {
+		in, out := &in.AuthorizerRef, &out.AuthorizerRef
+		*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.HTTPMethod != nil {
 		in, out := &in.HTTPMethod, &out.HTTPMethod
 		*out = new(string)
@@ -2354,6 +3377,11 @@ func (in *MethodSpec) DeepCopyInto(out *MethodSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.RequestValidatorRef != nil {
+		in, out := &in.RequestValidatorRef, &out.RequestValidatorRef
+		*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ResourceID != nil {
 		in, out := &in.ResourceID, &out.ResourceID
 		*out = new(string)
@@ -2549,6 +3577,146 @@ func (in *Method_SDK) DeepCopy() *Method_SDK {
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Model) DeepCopyInto(out *Model) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Model.
+func (in *Model) DeepCopy() *Model {
+	if in == nil {
+		return nil
+	}
+	out := new(Model)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Model) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ModelList) DeepCopyInto(out *ModelList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Model, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModelList.
+func (in *ModelList) DeepCopy() *ModelList {
+	if in == nil {
+		return nil
+	}
+	out := new(ModelList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ModelList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ModelSpec) DeepCopyInto(out *ModelSpec) {
+	*out = *in
+	if in.ContentType != nil {
+		in, out := &in.ContentType, &out.ContentType
+		*out = new(string)
+		**out = **in
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.RestAPIID != nil {
+		in, out := &in.RestAPIID, &out.RestAPIID
+		*out = new(string)
+		**out = **in
+	}
+	if in.RestAPIRef != nil {
+		in, out := &in.RestAPIRef, &out.RestAPIRef
+		*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Schema != nil {
+		in, out := &in.Schema, &out.Schema
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModelSpec.
+func (in *ModelSpec) DeepCopy() *ModelSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ModelSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ModelStatus) DeepCopyInto(out *ModelStatus) {
+	*out = *in
+	if in.ACKResourceMetadata != nil {
+		in, out := &in.ACKResourceMetadata, &out.ACKResourceMetadata
+		*out = new(corev1alpha1.ResourceMetadata)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]*corev1alpha1.Condition, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(corev1alpha1.Condition)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModelStatus.
+func (in *ModelStatus) DeepCopy() *ModelStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ModelStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Model_SDK) DeepCopyInto(out *Model_SDK) {
 	*out = *in
 	if in.ContentType != nil {
 		in, out := &in.ContentType, &out.ContentType
@@ -2577,12 +3745,12 @@ func (in *Model) DeepCopyInto(out *Model) {
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Model.
-func (in *Model) DeepCopy() *Model {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Model_SDK.
+func (in *Model_SDK) DeepCopy() *Model_SDK {
 	if in == nil {
 		return nil
 	}
-	out := new(Model)
+	out := new(Model_SDK)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -2604,106 +3772,276 @@ func (in *MutualTLSAuthentication) DeepCopyInto(out *MutualTLSAuthentication) {
 		in, out := &in.TruststoreWarnings, &out.TruststoreWarnings
 		*out = make([]*string, len(*in))
 		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(string)
-				**out = **in
-			}
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MutualTLSAuthentication.
+func (in *MutualTLSAuthentication) DeepCopy() *MutualTLSAuthentication {
+	if in == nil {
+		return nil
+	}
+	out := new(MutualTLSAuthentication)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MutualTLSAuthenticationInput) DeepCopyInto(out *MutualTLSAuthenticationInput) {
+	*out = *in
+	if in.TruststoreURI != nil {
+		in, out := &in.TruststoreURI, &out.TruststoreURI
+		*out = new(string)
+		**out = **in
+	}
+	if in.TruststoreVersion != nil {
+		in, out := &in.TruststoreVersion, &out.TruststoreVersion
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MutualTLSAuthenticationInput.
+func (in *MutualTLSAuthenticationInput) DeepCopy() *MutualTLSAuthenticationInput {
+	if in == nil {
+		return nil
+	}
+	out := new(MutualTLSAuthenticationInput)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PatchOperation) DeepCopyInto(out *PatchOperation) {
+	*out = *in
+	if in.From != nil {
+		in, out := &in.From, &out.From
+		*out = new(string)
+		**out = **in
+	}
+	if in.Op != nil {
+		in, out := &in.Op, &out.Op
+		*out = new(string)
+		**out = **in
+	}
+	if in.Path != nil {
+		in, out := &in.Path, &out.Path
+		*out = new(string)
+		**out = **in
+	}
+	if in.Value != nil {
+		in, out := &in.Value, &out.Value
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PatchOperation.
+func (in *PatchOperation) DeepCopy() *PatchOperation {
+	if in == nil {
+		return nil
+	}
+	out := new(PatchOperation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuotaSettings) DeepCopyInto(out *QuotaSettings) {
+	*out = *in
+	if in.Limit != nil {
+		in, out := &in.Limit, &out.Limit
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Offset != nil {
+		in, out := &in.Offset, &out.Offset
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuotaSettings.
+func (in *QuotaSettings) DeepCopy() *QuotaSettings {
+	if in == nil {
+		return nil
+	}
+	out := new(QuotaSettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RequestValidator) DeepCopyInto(out *RequestValidator) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RequestValidator.
+func (in *RequestValidator) DeepCopy() *RequestValidator {
+	if in == nil {
+		return nil
+	}
+	out := new(RequestValidator)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RequestValidator) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RequestValidatorList) DeepCopyInto(out *RequestValidatorList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]RequestValidator, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MutualTLSAuthentication.
-func (in *MutualTLSAuthentication) DeepCopy() *MutualTLSAuthentication {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RequestValidatorList.
+func (in *RequestValidatorList) DeepCopy() *RequestValidatorList {
 	if in == nil {
 		return nil
 	}
-	out := new(MutualTLSAuthentication)
+	out := new(RequestValidatorList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RequestValidatorList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MutualTLSAuthenticationInput) DeepCopyInto(out *MutualTLSAuthenticationInput) {
+func (in *RequestValidatorSpec) DeepCopyInto(out *RequestValidatorSpec) {
 	*out = *in
-	if in.TruststoreURI != nil {
-		in, out := &in.TruststoreURI, &out.TruststoreURI
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
 		*out = new(string)
 		**out = **in
 	}
-	if in.TruststoreVersion != nil {
-		in, out := &in.TruststoreVersion, &out.TruststoreVersion
+	if in.RestAPIID != nil {
+		in, out := &in.RestAPIID, &out.RestAPIID
 		*out = new(string)
 		**out = **in
 	}
+	if in.RestAPIRef != nil {
+		in, out := &in.RestAPIRef, &out.RestAPIRef
+		*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ValidateRequestBody != nil {
+		in, out := &in.ValidateRequestBody, &out.ValidateRequestBody
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ValidateRequestParameters != nil {
+		in, out := &in.ValidateRequestParameters, &out.ValidateRequestParameters
+		*out = new(bool)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MutualTLSAuthenticationInput.
-func (in *MutualTLSAuthenticationInput) DeepCopy() *MutualTLSAuthenticationInput {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RequestValidatorSpec.
+func (in *RequestValidatorSpec) DeepCopy() *RequestValidatorSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(MutualTLSAuthenticationInput)
+	out := new(RequestValidatorSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PatchOperation) DeepCopyInto(out *PatchOperation) {
+func (in *RequestValidatorStatus) DeepCopyInto(out *RequestValidatorStatus) {
 	*out = *in
-	if in.From != nil {
-		in, out := &in.From, &out.From
-		*out = new(string)
-		**out = **in
-	}
-	if in.Op != nil {
-		in, out := &in.Op, &out.Op
-		*out = new(string)
-		**out = **in
+	if in.ACKResourceMetadata != nil {
+		in, out := &in.ACKResourceMetadata, &out.ACKResourceMetadata
+		*out = new(corev1alpha1.ResourceMetadata)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.Path != nil {
-		in, out := &in.Path, &out.Path
-		*out = new(string)
-		**out = **in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]*corev1alpha1.Condition, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(corev1alpha1.Condition)
+				(*in).DeepCopyInto(*out)
+			}
+		}
 	}
-	if in.Value != nil {
-		in, out := &in.Value, &out.Value
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
 		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PatchOperation.
-func (in *PatchOperation) DeepCopy() *PatchOperation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RequestValidatorStatus.
+func (in *RequestValidatorStatus) DeepCopy() *RequestValidatorStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(PatchOperation)
+	out := new(RequestValidatorStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *QuotaSettings) DeepCopyInto(out *QuotaSettings) {
+func (in *RequestValidator_SDK) DeepCopyInto(out *RequestValidator_SDK) {
 	*out = *in
-	if in.Limit != nil {
-		in, out := &in.Limit, &out.Limit
-		*out = new(int64)
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
 		**out = **in
 	}
-	if in.Offset != nil {
-		in, out := &in.Offset, &out.Offset
-		*out = new(int64)
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.ValidateRequestBody != nil {
+		in, out := &in.ValidateRequestBody, &out.ValidateRequestBody
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ValidateRequestParameters != nil {
+		in, out := &in.ValidateRequestParameters, &out.ValidateRequestParameters
+		*out = new(bool)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuotaSettings.
-func (in *QuotaSettings) DeepCopy() *QuotaSettings {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RequestValidator_SDK.
+func (in *RequestValidator_SDK) DeepCopy() *RequestValidator_SDK {
 	if in == nil {
 		return nil
 	}
-	out := new(QuotaSettings)
+	out := new(RequestValidator_SDK)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -2770,6 +4108,11 @@ func (in *ResourceList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResourceSpec) DeepCopyInto(out *ResourceSpec) {
 	*out = *in
+	if in.CORS != nil {
+		in, out := &in.CORS, &out.CORS
+		*out = new(CORSConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ParentID != nil {
 		in, out := &in.ParentID, &out.ParentID
 		*out = new(string)
@@ -2942,6 +4285,118 @@ func (in *RestAPIList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RestAPIPolicyDeny) DeepCopyInto(out *RestAPIPolicyDeny) {
+	*out = *in
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+	if in.Principals != nil {
+		in, out := &in.Principals, &out.Principals
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+	if in.SourceIPs != nil {
+		in, out := &in.SourceIPs, &out.SourceIPs
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestAPIPolicyDeny.
+func (in *RestAPIPolicyDeny) DeepCopy() *RestAPIPolicyDeny {
+	if in == nil {
+		return nil
+	}
+	out := new(RestAPIPolicyDeny)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RestAPIPolicySpec) DeepCopyInto(out *RestAPIPolicySpec) {
+	*out = *in
+	if in.SourceVPCEndpointIDs != nil {
+		in, out := &in.SourceVPCEndpointIDs, &out.SourceVPCEndpointIDs
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+	if in.AllowEndpointConfigurationVPCEndpoints != nil {
+		in, out := &in.AllowEndpointConfigurationVPCEndpoints, &out.AllowEndpointConfigurationVPCEndpoints
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SourceIPs != nil {
+		in, out := &in.SourceIPs, &out.SourceIPs
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+	if in.Principals != nil {
+		in, out := &in.Principals, &out.Principals
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+	if in.Denies != nil {
+		in, out := &in.Denies, &out.Denies
+		*out = make([]*RestAPIPolicyDeny, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(RestAPIPolicyDeny)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestAPIPolicySpec.
+func (in *RestAPIPolicySpec) DeepCopy() *RestAPIPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RestAPIPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RestAPISpec) DeepCopyInto(out *RestAPISpec) {
 	*out = *in
@@ -2996,6 +4451,16 @@ func (in *RestAPISpec) DeepCopyInto(out *RestAPISpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.PolicySpec != nil {
+		in, out := &in.PolicySpec, &out.PolicySpec
+		*out = new(RestAPIPolicySpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ReplacementPolicy != nil {
+		in, out := &in.ReplacementPolicy, &out.ReplacementPolicy
+		*out = new(string)
+		**out = **in
+	}
 	if in.Tags != nil {
 		in, out := &in.Tags, &out.Tags
 		*out = make(map[string]*string, len(*in))
@@ -3262,6 +4727,31 @@ func (in *SDKType) DeepCopy() *SDKType {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceReference) DeepCopyInto(out *ServiceReference) {
+	*out = *in
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.Namespace != nil {
+		in, out := &in.Namespace, &out.Namespace
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceReference.
+func (in *ServiceReference) DeepCopy() *ServiceReference {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Stage) DeepCopyInto(out *Stage) {
 	*out = *in
@@ -3297,11 +4787,21 @@ func (in *StageKey) DeepCopyInto(out *StageKey) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.RestAPIRef != nil {
+		in, out := &in.RestAPIRef, &out.RestAPIRef
+		*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.StageName != nil {
 		in, out := &in.StageName, &out.StageName
 		*out = new(string)
 		**out = **in
 	}
+	if in.StageRef != nil {
+		in, out := &in.StageRef, &out.StageRef
+		*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StageKey.
@@ -3379,6 +4879,16 @@ func (in *StageSpec) DeepCopyInto(out *StageSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.DocumentationVersionRef != nil {
+		in, out := &in.DocumentationVersionRef, &out.DocumentationVersionRef
+		*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ReplacementPolicy != nil {
+		in, out := &in.ReplacementPolicy, &out.ReplacementPolicy
+		*out = new(string)
+		**out = **in
+	}
 	if in.RestAPIID != nil {
 		in, out := &in.RestAPIID, &out.RestAPIID
 		*out = new(string)
@@ -3852,6 +5362,11 @@ func (in *VPCLinkSpec) DeepCopyInto(out *VPCLinkSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.ReplacementPolicy != nil {
+		in, out := &in.ReplacementPolicy, &out.ReplacementPolicy
+		*out = new(string)
+		**out = **in
+	}
 	if in.Tags != nil {
 		in, out := &in.Tags, &out.Tags
 		*out = make(map[string]*string, len(*in))
@@ -3879,6 +5394,17 @@ func (in *VPCLinkSpec) DeepCopyInto(out *VPCLinkSpec) {
 			}
 		}
 	}
+	if in.TargetRefs != nil {
+		in, out := &in.TargetRefs, &out.TargetRefs
+		*out = make([]*VPCLinkTargetReference, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(VPCLinkTargetReference)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCLinkSpec.
@@ -3915,6 +5441,11 @@ func (in *VPCLinkStatus) DeepCopyInto(out *VPCLinkStatus) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.ReplacedID != nil {
+		in, out := &in.ReplacedID, &out.ReplacedID
+		*out = new(string)
+		**out = **in
+	}
 	if in.Status != nil {
 		in, out := &in.Status, &out.Status
 		*out = new(string)
@@ -3937,6 +5468,31 @@ func (in *VPCLinkStatus) DeepCopy() *VPCLinkStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCLinkTargetReference) DeepCopyInto(out *VPCLinkTargetReference) {
+	*out = *in
+	if in.LoadBalancerRef != nil {
+		in, out := &in.LoadBalancerRef, &out.LoadBalancerRef
+		*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ServiceRef != nil {
+		in, out := &in.ServiceRef, &out.ServiceRef
+		*out = new(ServiceReference)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCLinkTargetReference.
+func (in *VPCLinkTargetReference) DeepCopy() *VPCLinkTargetReference {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCLinkTargetReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VPCLink_SDK) DeepCopyInto(out *VPCLink_SDK) {
 	*out = *in
@@ -0,0 +1,74 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package main
+
+import (
+	"errors"
+	"io"
+	"os"
+
+	flag "github.com/spf13/pflag"
+
+	"github.com/aws-controllers-k8s/apigateway-controller/pkg/export"
+)
+
+// convertOpenAPICommand is the subcommand converting an OpenAPI document into
+// the manifests of the equivalent REST API. It runs without AWS credentials.
+const convertOpenAPICommand = "convert-openapi"
+
+func runConvertOpenAPI(args []string) error {
+	flags := flag.NewFlagSet(convertOpenAPICommand, flag.ContinueOnError)
+	input := flags.StringP("file", "f", "", "Swagger 2 or OpenAPI 3 document to convert, in JSON or YAML. Defaults to standard input.")
+	namespace := flags.String("namespace", "default", "Namespace of the generated resources.")
+	rootResourceID := flags.String("root-resource-id", "", "ID of the root resource of the REST API, as reported in the status of the RestAPI.")
+	output := flags.StringP("output", "o", "", "File to write the manifests to. Defaults to standard output.")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if *rootResourceID == "" {
+		return errors.New("--root-resource-id is required")
+	}
+
+	var r io.Reader = os.Stdin
+	if *input != "" {
+		f, err := os.Open(*input)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		r = f
+	}
+	doc, err := io.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	manifests, err := export.ConvertOpenAPI(doc, export.ConvertOptions{
+		Namespace:      *namespace,
+		RootResourceID: *rootResourceID,
+	})
+	if err != nil {
+		return err
+	}
+
+	var w io.Writer = os.Stdout
+	if *output != "" {
+		f, err := os.Create(*output)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		w = f
+	}
+	return export.WriteYAML(w, manifests.Objects())
+}
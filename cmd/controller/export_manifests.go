@@ -0,0 +1,70 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package main
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+
+	"github.com/aws/aws-sdk-go-v2/config"
+	svcsdk "github.com/aws/aws-sdk-go-v2/service/apigateway"
+	flag "github.com/spf13/pflag"
+
+	"github.com/aws-controllers-k8s/apigateway-controller/pkg/export"
+)
+
+// exportManifestsCommand is the subcommand writing the manifests of an
+// existing REST API, so that it can be adopted by the controller.
+const exportManifestsCommand = "export-manifests"
+
+func runExportManifests(args []string) error {
+	flags := flag.NewFlagSet(exportManifestsCommand, flag.ContinueOnError)
+	restAPIID := flags.String("rest-api-id", "", "ID of the REST API to export.")
+	namespace := flags.String("namespace", "default", "Namespace of the exported resources.")
+	region := flags.String("aws-region", "", "AWS region of the REST API. Defaults to the region of the AWS configuration.")
+	output := flags.StringP("output", "o", "", "File to write the manifests to. Defaults to standard output.")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if *restAPIID == "" {
+		return errors.New("--rest-api-id is required")
+	}
+
+	ctx := context.Background()
+	var opts []func(*config.LoadOptions) error
+	if *region != "" {
+		opts = append(opts, config.WithRegion(*region))
+	}
+	cfg, err := config.LoadDefaultConfig(ctx, opts...)
+	if err != nil {
+		return err
+	}
+	manifests, err := export.Export(ctx, svcsdk.NewFromConfig(cfg), *restAPIID, *namespace)
+	if err != nil {
+		return err
+	}
+
+	var w io.Writer = os.Stdout
+	if *output != "" {
+		f, err := os.Create(*output)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		w = f
+	}
+	return export.WriteYAML(w, manifests.Objects())
+}
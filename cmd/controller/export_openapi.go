@@ -0,0 +1,135 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	flag "github.com/spf13/pflag"
+	ctrlrt "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	"github.com/aws-controllers-k8s/apigateway-controller/pkg/export"
+)
+
+// exportOpenAPICommand is the subcommand building the OpenAPI document of a
+// REST API from its manifests, or from its resources in a cluster. AWS is not
+// called.
+const exportOpenAPICommand = "export-openapi"
+
+func runExportOpenAPI(args []string) error {
+	flags := flag.NewFlagSet(exportOpenAPICommand, flag.ContinueOnError)
+	input := flags.StringP("file", "f", "", "Manifest file, or directory of manifest files, to read the resources from.")
+	namespace := flags.String("namespace", "", "Namespace of the cluster to read the resources from, when no manifests are given.")
+	restAPI := flags.String("rest-api", "", "Name of the RestAPI to export. Required when the resources describe several REST APIs.")
+	format := flags.String("format", "yaml", "Format of the OpenAPI document, yaml or json.")
+	output := flags.StringP("output", "o", "", "File to write the OpenAPI document to. Defaults to standard output.")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if (*input == "") == (*namespace == "") {
+		return errors.New("exactly one of --file and --namespace is required")
+	}
+	if *format != "yaml" && *format != "json" {
+		return fmt.Errorf("unsupported format %q", *format)
+	}
+
+	var objs []client.Object
+	var err error
+	if *input != "" {
+		objs, err = readManifests(*input)
+	} else {
+		objs, err = listObjects(*namespace)
+	}
+	if err != nil {
+		return err
+	}
+	manifests, err := export.SelectRestAPI(objs, *restAPI)
+	if err != nil {
+		return err
+	}
+	doc, err := export.BuildOpenAPI(manifests)
+	if err != nil {
+		return err
+	}
+	var out []byte
+	if *format == "json" {
+		out, err = json.MarshalIndent(doc, "", "  ")
+	} else {
+		out, err = yaml.Marshal(doc)
+	}
+	if err != nil {
+		return err
+	}
+
+	var w io.Writer = os.Stdout
+	if *output != "" {
+		f, err := os.Create(*output)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		w = f
+	}
+	_, err = w.Write(out)
+	return err
+}
+
+// readManifests reads the resources of the manifest files found under path.
+func readManifests(path string) ([]client.Object, error) {
+	var objs []client.Object
+	err := filepath.WalkDir(path, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		switch filepath.Ext(p) {
+		case ".yaml", ".yml", ".json":
+		default:
+			return nil
+		}
+		f, err := os.Open(p)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		fileObjs, err := export.ReadYAML(f)
+		if err != nil {
+			return fmt.Errorf("reading %s: %w", p, err)
+		}
+		objs = append(objs, fileObjs...)
+		return nil
+	})
+	return objs, err
+}
+
+// listObjects lists the resources of a namespace of the current cluster.
+func listObjects(namespace string) ([]client.Object, error) {
+	cfg, err := ctrlrt.GetConfig()
+	if err != nil {
+		return nil, err
+	}
+	c, err := client.New(cfg, client.Options{Scheme: scheme})
+	if err != nil {
+		return nil, err
+	}
+	return export.ListObjects(context.Background(), c, namespace)
+}
@@ -18,6 +18,7 @@ package main
 import (
 	"context"
 	"os"
+	"time"
 
 	ec2apitypes "github.com/aws-controllers-k8s/ec2-controller/apis/v1alpha1"
 	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
@@ -38,15 +39,31 @@ import (
 	ctrlrtwebhook "sigs.k8s.io/controller-runtime/pkg/webhook"
 
 	svctypes "github.com/aws-controllers-k8s/apigateway-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/apigateway-controller/pkg/apikey"
+	"github.com/aws-controllers-k8s/apigateway-controller/pkg/dryrun"
+	"github.com/aws-controllers-k8s/apigateway-controller/pkg/gateway"
+	"github.com/aws-controllers-k8s/apigateway-controller/pkg/observe"
+	"github.com/aws-controllers-k8s/apigateway-controller/pkg/ratelimit"
+	"github.com/aws-controllers-k8s/apigateway-controller/pkg/references"
 	svcresource "github.com/aws-controllers-k8s/apigateway-controller/pkg/resource"
+	"github.com/aws-controllers-k8s/apigateway-controller/pkg/restapilock"
+	"github.com/aws-controllers-k8s/apigateway-controller/pkg/treecache"
+	"github.com/aws-controllers-k8s/apigateway-controller/pkg/usage"
 
+	_ "github.com/aws-controllers-k8s/apigateway-controller/pkg/resource/account"
 	_ "github.com/aws-controllers-k8s/apigateway-controller/pkg/resource/api_integration_response"
 	_ "github.com/aws-controllers-k8s/apigateway-controller/pkg/resource/api_key"
+	_ "github.com/aws-controllers-k8s/apigateway-controller/pkg/resource/api_key_import"
 	_ "github.com/aws-controllers-k8s/apigateway-controller/pkg/resource/api_method_response"
 	_ "github.com/aws-controllers-k8s/apigateway-controller/pkg/resource/authorizer"
 	_ "github.com/aws-controllers-k8s/apigateway-controller/pkg/resource/deployment"
+	_ "github.com/aws-controllers-k8s/apigateway-controller/pkg/resource/documentation_part"
+	_ "github.com/aws-controllers-k8s/apigateway-controller/pkg/resource/documentation_version"
+	_ "github.com/aws-controllers-k8s/apigateway-controller/pkg/resource/gateway_response"
 	_ "github.com/aws-controllers-k8s/apigateway-controller/pkg/resource/integration"
 	_ "github.com/aws-controllers-k8s/apigateway-controller/pkg/resource/method"
+	_ "github.com/aws-controllers-k8s/apigateway-controller/pkg/resource/model"
+	_ "github.com/aws-controllers-k8s/apigateway-controller/pkg/resource/request_validator"
 	_ "github.com/aws-controllers-k8s/apigateway-controller/pkg/resource/resource"
 	_ "github.com/aws-controllers-k8s/apigateway-controller/pkg/resource/rest_api"
 	_ "github.com/aws-controllers-k8s/apigateway-controller/pkg/resource/stage"
@@ -71,14 +88,96 @@ func init() {
 }
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == exportManifestsCommand {
+		if err := runExportManifests(os.Args[2:]); err != nil {
+			setupLog.Error(err, "Unable to export manifests")
+			os.Exit(1)
+		}
+		return
+	}
+	if len(os.Args) > 1 && os.Args[1] == convertOpenAPICommand {
+		if err := runConvertOpenAPI(os.Args[2:]); err != nil {
+			setupLog.Error(err, "Unable to convert OpenAPI document")
+			os.Exit(1)
+		}
+		return
+	}
+	if len(os.Args) > 1 && os.Args[1] == exportOpenAPICommand {
+		if err := runExportOpenAPI(os.Args[2:]); err != nil {
+			setupLog.Error(err, "Unable to export OpenAPI document")
+			os.Exit(1)
+		}
+		return
+	}
+
 	var ackCfg ackcfg.Config
+	var enableGatewayAPI bool
+	var dryRun bool
+	var observeOnly bool
+	var usageMetricsInterval time.Duration
+	var rateLimits ratelimit.Config
+	var resourceTreeTTL time.Duration
 	ackCfg.BindFlags()
+	flag.BoolVar(
+		&enableGatewayAPI, "enable-gateway-api",
+		false,
+		"Translate Gateway API Gateways and HTTPRoutes of GatewayClasses with controllerName "+
+			gateway.ControllerName+" into API Gateway REST APIs.",
+	)
+	flag.BoolVar(
+		&dryRun, "dry-run",
+		false,
+		"Report the API calls that would create, update or delete API Gateway resources "+
+			"in the ResourceSynced condition of the resources instead of sending them. "+
+			"Resources override it with the "+dryrun.AnnotationDryRun+" annotation.",
+	)
+	flag.BoolVar(
+		&observeOnly, "observe-only",
+		false,
+		"Only read API Gateway resources, reporting the differences with their spec in a "+
+			string(observe.ConditionTypeDrifted)+" condition, and never create, update, tag or delete them. "+
+			"Resources override it with the "+observe.AnnotationObserveOnly+" annotation.",
+	)
+	flag.DurationVar(
+		&usageMetricsInterval, "usage-metrics-interval",
+		0,
+		"Interval at which the daily usage of the APIKeys is read from their usage plans and "+
+			"exposed as Prometheus metrics. 0 disables the usage metrics.",
+	)
+	flag.Float64Var(
+		&rateLimits.ReadRate, "api-read-rate",
+		5,
+		"Calls per second reading API Gateway resources allowed per account and region, "+
+			"lowered while API Gateway throttles them. 0 disables the limit.",
+	)
+	flag.IntVar(
+		&rateLimits.ReadBurst, "api-read-burst",
+		10,
+		"Calls reading API Gateway resources that can be made at once per account and region.",
+	)
+	flag.Float64Var(
+		&rateLimits.MutateRate, "api-mutate-rate",
+		2,
+		"Calls per second creating, updating or deleting API Gateway resources allowed per account "+
+			"and region, lowered while API Gateway throttles them. 0 disables the limit.",
+	)
+	flag.IntVar(
+		&rateLimits.MutateBurst, "api-mutate-burst",
+		5,
+		"Calls creating, updating or deleting API Gateway resources that can be made at once "+
+			"per account and region.",
+	)
+	flag.DurationVar(
+		&resourceTreeTTL, "resource-tree-ttl",
+		10*time.Second,
+		"Time for which the resources, methods and integrations of a RestAPI, read at once, are cached "+
+			"for the reads of their resources. 0 disables the cache.",
+	)
 	flag.Parse()
 	ackCfg.SetupLogger()
 
-	managerFactories := svcresource.GetManagerFactories()
-	resourceGVKs := make([]schema.GroupVersionKind, 0, len(managerFactories))
-	for _, mf := range managerFactories {
+	resourceGVKs := make([]schema.GroupVersionKind, 0, len(svcresource.GetManagerFactories()))
+	for _, mf := range svcresource.GetManagerFactories() {
 		resourceGVKs = append(resourceGVKs, mf.ResourceDescriptor().GroupVersionKind())
 	}
 
@@ -150,6 +249,36 @@ func main() {
 		os.Exit(1)
 	}
 
+	limiters := ratelimit.NewLimiters(rateLimits)
+	factories := apikey.WrapManagerFactories(references.WrapManagerFactories(svcresource.GetManagerFactories()), mgr.GetClient(), mgr.GetAPIReader())
+	if usageMetricsInterval > 0 {
+		reporter, err := usage.NewReporter(mgr.GetClient(), usageMetricsInterval, ctrlrt.Log.WithName("usage"), ctrlrtmetrics.Registry)
+		if err == nil {
+			err = mgr.Add(reporter)
+		}
+		if err != nil {
+			setupLog.Error(
+				err, "unable to set up usage metrics",
+				"aws.service", awsServiceAlias,
+			)
+			os.Exit(1)
+		}
+		factories = usage.WrapManagerFactories(factories, reporter)
+	}
+	if resourceTreeTTL > 0 {
+		factories = treecache.WrapManagerFactories(factories, treecache.NewCaches(resourceTreeTTL))
+	}
+	managerFactories := observe.WrapManagerFactories(
+		dryrun.WrapManagerFactories(
+			restapilock.WrapManagerFactories(
+				ratelimit.WrapManagerFactories(factories, limiters),
+				restapilock.NewLocks(),
+			),
+			dryRun,
+		),
+		observeOnly,
+	)
+
 	stopChan := ctrlrt.SetupSignalHandler()
 
 	setupLog.Info(
@@ -166,7 +295,7 @@ func main() {
 	).WithLogger(
 		ctrlrt.Log,
 	).WithResourceManagerFactories(
-		svcresource.GetManagerFactories(),
+		managerFactories,
 	).WithPrometheusRegistry(
 		ctrlrtmetrics.Registry,
 	)
@@ -191,6 +320,16 @@ func main() {
 		os.Exit(1)
 	}
 
+	if enableGatewayAPI {
+		if err = gateway.SetupWithManager(mgr); err != nil {
+			setupLog.Error(
+				err, "unable to set up Gateway API controllers",
+				"aws.service", awsServiceAlias,
+			)
+			os.Exit(1)
+		}
+	}
+
 	if err = mgr.AddHealthzCheck("health", ctrlrthealthz.Ping); err != nil {
 		setupLog.Error(
 			err, "unable to set up health check",
@@ -55,7 +55,6 @@ rules:
   - ""
   resources:
   - configmaps
-  - secrets
   verbs:
   - get
   - list
@@ -65,20 +64,39 @@ rules:
   - ""
   resources:
   - namespaces
+  - services
   verbs:
   - get
   - list
   - watch
+- apiGroups:
+  - ""
+  resources:
+  - secrets
+  verbs:
+  - create
+  - get
+  - list
+  - patch
+  - update
+  - watch
 - apiGroups:
   - apigateway.services.k8s.aws
   resources:
+  - accounts
   - apiintegrationresponses
+  - apikeyimports
   - apikeys
   - apimethodresponses
   - authorizers
   - deployments
+  - documentationparts
+  - documentationversions
+  - gatewayresponses
   - integrations
   - methods
+  - models
+  - requestvalidators
   - resources
   - restapis
   - stages
@@ -94,13 +112,20 @@ rules:
 - apiGroups:
   - apigateway.services.k8s.aws
   resources:
+  - accounts/status
   - apiintegrationresponses/status
+  - apikeyimports/status
   - apikeys/status
   - apimethodresponses/status
   - authorizers/status
   - deployments/status
+  - documentationparts/status
+  - documentationversions/status
+  - gatewayresponses/status
   - integrations/status
   - methods/status
+  - models/status
+  - requestvalidators/status
   - resources/status
   - restapis/status
   - stages/status
@@ -109,6 +134,20 @@ rules:
   - get
   - patch
   - update
+- apiGroups:
+  - apigateway.services.k8s.aws
+  resources:
+  - apikeys/finalizers
+  verbs:
+  - update
+- apiGroups:
+  - cognitoidentityprovider.services.k8s.aws
+  resources:
+  - userpools
+  - userpools/status
+  verbs:
+  - get
+  - list
 - apiGroups:
   - ec2.services.k8s.aws
   resources:
@@ -117,6 +156,43 @@ rules:
   verbs:
   - get
   - list
+- apiGroups:
+  - elbv2.services.k8s.aws
+  resources:
+  - loadbalancers
+  - loadbalancers/status
+  verbs:
+  - get
+  - list
+- apiGroups:
+  - gateway.networking.k8s.io
+  resources:
+  - gatewayclasses
+  - gateways
+  - httproutes
+  - referencegrants
+  verbs:
+  - get
+  - list
+  - watch
+- apiGroups:
+  - gateway.networking.k8s.io
+  resources:
+  - gatewayclasses/status
+  - gateways/status
+  - httproutes/status
+  verbs:
+  - get
+  - patch
+  - update
+- apiGroups:
+  - iam.services.k8s.aws
+  resources:
+  - roles
+  - roles/status
+  verbs:
+  - get
+  - list
 - apiGroups:
   - services.k8s.aws
   resources:
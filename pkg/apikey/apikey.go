@@ -0,0 +1,209 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+// Package apikey writes the values API Gateway generates for the APIKey
+// resources with a spec.generatedValueSecret into Secrets owned by the
+// APIKeys, so that applications can mount the keys without anyone reading
+// them. The Secrets follow the rotations of the keys.
+package apikey
+
+import (
+	"context"
+	"fmt"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+	ackcfg "github.com/aws-controllers-k8s/runtime/pkg/config"
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	ackmetrics "github.com/aws-controllers-k8s/runtime/pkg/metrics"
+	acktypes "github.com/aws-controllers-k8s/runtime/pkg/types"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	svcsdk "github.com/aws/aws-sdk-go-v2/service/apigateway"
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	svcapitypes "github.com/aws-controllers-k8s/apigateway-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/apigateway-controller/pkg/observe"
+)
+
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch;create;update;patch
+// +kubebuilder:rbac:groups=apigateway.services.k8s.aws,resources=apikeys/finalizers,verbs=update
+
+// AnnotationAPIKeyID is the annotation of the Secrets holding the identifier
+// of the API key whose value they hold, so that they are written again when
+// the key is rotated.
+const AnnotationAPIKeyID = "apigateway.services.k8s.aws/api-key-id"
+
+// DefaultSecretKey is the key of the value in the Secret when
+// spec.generatedValueSecret.key is not set.
+const DefaultSecretKey = "value"
+
+// WrapManagerFactories returns resource manager factories whose APIKey
+// managers write the generated values of the API keys into Secrets. kc
+// writes the Secrets, which apiReader reads uncached.
+func WrapManagerFactories(
+	factories []acktypes.AWSResourceManagerFactory,
+	kc client.Client,
+	apiReader client.Reader,
+) []acktypes.AWSResourceManagerFactory {
+	wrapped := make([]acktypes.AWSResourceManagerFactory, 0, len(factories))
+	for _, f := range factories {
+		if f.ResourceDescriptor().GroupVersionKind().Kind != "APIKey" {
+			wrapped = append(wrapped, f)
+			continue
+		}
+		wrapped = append(wrapped, &managerFactory{AWSResourceManagerFactory: f, kc: kc, apiReader: apiReader})
+	}
+	return wrapped
+}
+
+type managerFactory struct {
+	acktypes.AWSResourceManagerFactory
+	kc        client.Client
+	apiReader client.Reader
+}
+
+// ManagerFor returns an APIKey resource manager writing the generated values
+// of the API keys into Secrets.
+func (f *managerFactory) ManagerFor(
+	cfg ackcfg.Config,
+	clientcfg aws.Config,
+	log logr.Logger,
+	metrics *ackmetrics.Metrics,
+	rr acktypes.Reconciler,
+	id ackv1alpha1.AWSAccountID,
+	region ackv1alpha1.AWSRegion,
+	roleARN ackv1alpha1.AWSResourceName,
+) (acktypes.AWSResourceManager, error) {
+	rm, err := f.AWSResourceManagerFactory.ManagerFor(cfg, clientcfg, log, metrics, rr, id, region, roleARN)
+	if err != nil {
+		return nil, err
+	}
+	return &resourceManager{
+		AWSResourceManager: rm,
+		sdkapi:             svcsdk.NewFromConfig(clientcfg),
+		metrics:            metrics,
+		kc:                 f.kc,
+		apiReader:          f.apiReader,
+	}, nil
+}
+
+type resourceManager struct {
+	acktypes.AWSResourceManager
+	sdkapi    *svcsdk.Client
+	metrics   *ackmetrics.Metrics
+	kc        client.Client
+	apiReader client.Reader
+}
+
+// ReadOne returns the observed API key, once its generated value is in its
+// Secret. The runtime reads every API key it creates, so the Secret is written
+// right after the creation, and written again if it is deleted or loses the
+// value.
+func (rm *resourceManager) ReadOne(
+	ctx context.Context,
+	res acktypes.AWSResource,
+) (acktypes.AWSResource, error) {
+	latest, err := rm.AWSResourceManager.ReadOne(ctx, res)
+	if err != nil || latest.IsBeingDeleted() {
+		return latest, err
+	}
+	return latest, rm.ensureSecret(ctx, latest)
+}
+
+// Update returns the updated API key, once the value of the key it was
+// rotated to, if any, is in its Secret.
+func (rm *resourceManager) Update(
+	ctx context.Context,
+	desired acktypes.AWSResource,
+	latest acktypes.AWSResource,
+	delta *ackcompare.Delta,
+) (acktypes.AWSResource, error) {
+	updated, err := rm.AWSResourceManager.Update(ctx, desired, latest, delta)
+	if err != nil {
+		return updated, err
+	}
+	return updated, rm.ensureSecret(ctx, updated)
+}
+
+// ensureSecret writes the value of an API key into the Secret of its
+// spec.generatedValueSecret, unless the Secret already holds it. A Secret that
+// is not controlled by the APIKey is left untouched, and so are all Secrets
+// while the APIKey is only observed.
+func (rm *resourceManager) ensureSecret(ctx context.Context, res acktypes.AWSResource) error {
+	ko, ok := res.RuntimeObject().(*svcapitypes.APIKey)
+	if !ok || ko.Spec.GeneratedValueSecret == nil || ko.Spec.Value != nil || aws.ToString(ko.Status.ID) == "" {
+		return nil
+	}
+	if observe.ObserveOnly(ctx) {
+		return nil
+	}
+	name := aws.ToString(ko.Spec.GeneratedValueSecret.Name)
+	key := DefaultSecretKey
+	if ko.Spec.GeneratedValueSecret.Key != nil {
+		key = *ko.Spec.GeneratedValueSecret.Key
+	}
+
+	secret := &corev1.Secret{}
+	err := rm.apiReader.Get(ctx, client.ObjectKey{Namespace: ko.Namespace, Name: name}, secret)
+	exists := err == nil
+	if err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	if exists && !metav1.IsControlledBy(secret, ko) {
+		return ackerr.NewTerminalError(fmt.Errorf(
+			"secret %s/%s exists and is not controlled by the APIKey", ko.Namespace, name))
+	}
+	if exists && secret.Annotations[AnnotationAPIKeyID] == *ko.Status.ID && len(secret.Data[key]) > 0 {
+		return nil
+	}
+
+	resp, err := rm.sdkapi.GetApiKey(ctx, &svcsdk.GetApiKeyInput{
+		ApiKey:       ko.Status.ID,
+		IncludeValue: aws.Bool(true),
+	})
+	rm.metrics.RecordAPICall("READ_ONE", "GetApiKey", err)
+	if err != nil {
+		return err
+	}
+	value := []byte(aws.ToString(resp.Value))
+
+	if exists {
+		if secret.Data == nil {
+			secret.Data = map[string][]byte{}
+		}
+		secret.Data[key] = value
+		metav1.SetMetaDataAnnotation(&secret.ObjectMeta, AnnotationAPIKeyID, *ko.Status.ID)
+		return rm.kc.Update(ctx, secret)
+	}
+	return rm.kc.Create(ctx, &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Namespace:   ko.Namespace,
+			Annotations: map[string]string{AnnotationAPIKeyID: *ko.Status.ID},
+			OwnerReferences: []metav1.OwnerReference{{
+				APIVersion:         svcapitypes.GroupVersion.String(),
+				Kind:               "APIKey",
+				Name:               ko.Name,
+				UID:                ko.UID,
+				Controller:         aws.Bool(true),
+				BlockOwnerDeletion: aws.Bool(true),
+			}},
+		},
+		Type: corev1.SecretTypeOpaque,
+		Data: map[string][]byte{key: value},
+	})
+}
@@ -0,0 +1,310 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package apikey_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	ackcfg "github.com/aws-controllers-k8s/runtime/pkg/config"
+	ackmetrics "github.com/aws-controllers-k8s/runtime/pkg/metrics"
+	acktypes "github.com/aws-controllers-k8s/runtime/pkg/types"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	svcapitypes "github.com/aws-controllers-k8s/apigateway-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/apigateway-controller/pkg/apikey"
+	"github.com/aws-controllers-k8s/apigateway-controller/pkg/observe"
+	svcresource "github.com/aws-controllers-k8s/apigateway-controller/pkg/resource"
+	"github.com/aws-controllers-k8s/apigateway-controller/pkg/util"
+
+	_ "github.com/aws-controllers-k8s/apigateway-controller/pkg/resource/api_key"
+)
+
+// transport answers the calls on the API keys key1 and key2, with their
+// values when they are requested, on the usage plan plan1 of key1, and on the
+// API key key3 of the stage api1/prod, and records the requests sent. The
+// request fail, if any, is answered with a BadRequestException.
+type transport struct {
+	sent   []string
+	bodies []string
+	fail   string
+}
+
+func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.sent = append(t.sent, req.Method+" "+req.URL.RequestURI())
+	var sentBody []byte
+	if req.Body != nil {
+		sentBody, _ = io.ReadAll(req.Body)
+	}
+	t.bodies = append(t.bodies, string(sentBody))
+	body := "{}"
+	if req.Method+" "+req.URL.Path == t.fail {
+		return &http.Response{
+			StatusCode: http.StatusBadRequest,
+			Header: http.Header{
+				"Content-Type":     []string{"application/json"},
+				"X-Amzn-Errortype": []string{"BadRequestException"},
+			},
+			Body:    io.NopCloser(strings.NewReader(`{"message": "bad request"}`)),
+			Request: req,
+		}, nil
+	}
+	switch req.Method + " " + req.URL.Path {
+	case "GET /apikeys/key3":
+		body = `{"id": "key3", "name": "key", "enabled": true, "stageKeys": ["api1/prod"]}`
+	case "GET /apikeys/key1", "GET /apikeys/key2":
+		id := strings.TrimPrefix(req.URL.Path, "/apikeys/")
+		body = fmt.Sprintf(`{"id": %q, "name": "key", "enabled": true}`, id)
+		if req.URL.Query().Get("includeValue") == "true" {
+			body = fmt.Sprintf(`{"id": %q, "name": "key", "enabled": true, "value": "value-%s"}`, id, id)
+		}
+	case "POST /apikeys":
+		body = `{"id": "key2", "name": "key", "enabled": true}`
+	case "GET /usageplans":
+		if req.URL.Query().Get("keyId") == "key1" {
+			body = `{"item": [{"id": "plan1"}]}`
+		}
+	}
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       io.NopCloser(strings.NewReader(body)),
+		Request:    req,
+	}, nil
+}
+
+var accounts int
+
+func apiKeyManager(t *testing.T, kc client.Client) (acktypes.AWSResourceManager, acktypes.AWSResourceDescriptor, *transport) {
+	t.Helper()
+	var factory acktypes.AWSResourceManagerFactory
+	// Resources are only observed with the observe.AnnotationObserveOnly
+	// annotation.
+	factories := observe.WrapManagerFactories(apikey.WrapManagerFactories(svcresource.GetManagerFactories(), kc, kc), false)
+	for _, f := range factories {
+		if f.ResourceDescriptor().GroupVersionKind().Kind == "APIKey" {
+			factory = f
+		}
+	}
+	require.NotNil(t, factory)
+
+	tr := &transport{}
+	clientcfg := aws.Config{
+		Region:      "us-west-2",
+		Credentials: credentials.NewStaticCredentialsProvider("AKID", "SECRET", ""),
+		HTTPClient:  &http.Client{Transport: tr},
+	}
+	// The resource managers are cached by account, so that every manager
+	// gets its own client.
+	accounts++
+	rm, err := factory.ManagerFor(ackcfg.Config{}, clientcfg, logr.Discard(), ackmetrics.NewMetrics("apigateway"),
+		nil, ackv1alpha1.AWSAccountID(fmt.Sprintf("%012d", accounts)), "us-west-2", "")
+	require.NoError(t, err)
+	return rm, factory.ResourceDescriptor(), tr
+}
+
+func apiKey(valueSecret *svcapitypes.APIKeyValueSecret) *svcapitypes.APIKey {
+	return &svcapitypes.APIKey{
+		ObjectMeta: metav1.ObjectMeta{Name: "key", Namespace: "default", UID: "uid"},
+		Spec: svcapitypes.APIKeySpec{
+			Name:                 aws.String("key"),
+			Enabled:              aws.Bool(true),
+			GeneratedValueSecret: valueSecret,
+		},
+		Status: svcapitypes.APIKeyStatus{ID: aws.String("key1")},
+	}
+}
+
+func TestReadOne_WritesSecret(t *testing.T) {
+	kc := fake.NewClientBuilder().Build()
+	rm, rd, tr := apiKeyManager(t, kc)
+	desired := rd.ResourceFromRuntimeObject(apiKey(&svcapitypes.APIKeyValueSecret{Name: aws.String("key-value")}))
+
+	_, err := rm.ReadOne(context.Background(), desired)
+	require.NoError(t, err)
+	secret := &corev1.Secret{}
+	require.NoError(t, kc.Get(context.Background(), client.ObjectKey{Namespace: "default", Name: "key-value"}, secret))
+	assert.Equal(t, "value-key1", string(secret.Data[apikey.DefaultSecretKey]))
+	require.Len(t, secret.OwnerReferences, 1)
+	assert.Equal(t, "APIKey", secret.OwnerReferences[0].Kind)
+	assert.Equal(t, "uid", string(secret.OwnerReferences[0].UID))
+
+	// The value is only read again once it is missing from the Secret.
+	sent := len(tr.sent)
+	_, err = rm.ReadOne(context.Background(), desired)
+	require.NoError(t, err)
+	assert.Len(t, tr.sent, sent+1)
+}
+
+func TestReadOne_SecretNotControlled(t *testing.T) {
+	kc := fake.NewClientBuilder().WithObjects(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "default"},
+		Data:       map[string][]byte{"token": []byte("mine")},
+	}).Build()
+	rm, rd, _ := apiKeyManager(t, kc)
+	desired := rd.ResourceFromRuntimeObject(apiKey(&svcapitypes.APIKeyValueSecret{
+		Name: aws.String("other"),
+		Key:  aws.String("token"),
+	}))
+
+	_, err := rm.ReadOne(context.Background(), desired)
+	assert.ErrorContains(t, err, "not controlled by the APIKey")
+	secret := &corev1.Secret{}
+	require.NoError(t, kc.Get(context.Background(), client.ObjectKey{Namespace: "default", Name: "other"}, secret))
+	assert.Equal(t, "mine", string(secret.Data["token"]))
+}
+
+func TestReadOne_ObserveOnly(t *testing.T) {
+	kc := fake.NewClientBuilder().Build()
+	rm, rd, tr := apiKeyManager(t, kc)
+	ko := apiKey(&svcapitypes.APIKeyValueSecret{Name: aws.String("key-value")})
+	ko.Annotations = map[string]string{observe.AnnotationObserveOnly: "true"}
+
+	_, err := rm.ReadOne(context.Background(), rd.ResourceFromRuntimeObject(ko))
+	require.NoError(t, err)
+	for _, sent := range tr.sent {
+		assert.NotContains(t, sent, "includeValue")
+	}
+	secrets := &corev1.SecretList{}
+	require.NoError(t, kc.List(context.Background(), secrets))
+	assert.Empty(t, secrets.Items)
+}
+
+func TestReadOne_NoValueSecret(t *testing.T) {
+	kc := fake.NewClientBuilder().Build()
+	rm, rd, tr := apiKeyManager(t, kc)
+
+	_, err := rm.ReadOne(context.Background(), rd.ResourceFromRuntimeObject(apiKey(nil)))
+	require.NoError(t, err)
+	for _, sent := range tr.sent {
+		assert.NotContains(t, sent, "includeValue")
+	}
+	secrets := &corev1.SecretList{}
+	require.NoError(t, kc.List(context.Background(), secrets))
+	assert.Empty(t, secrets.Items)
+}
+
+func TestUpdate_Rotation(t *testing.T) {
+	kc := fake.NewClientBuilder().Build()
+	rm, rd, tr := apiKeyManager(t, kc)
+	ko := apiKey(&svcapitypes.APIKeyValueSecret{Name: aws.String("key-value")})
+	ko.Annotations = map[string]string{util.AnnotationRotate: "1"}
+	ko.Spec.Rotation = &svcapitypes.APIKeyRotation{Overlap: &metav1.Duration{Duration: time.Hour}}
+	ko.Status.RotationStatus = &svcapitypes.APIKeyRotationStatus{LastRequest: aws.String("0")}
+	desired := rd.ResourceFromRuntimeObject(ko)
+
+	latest, err := rm.ReadOne(context.Background(), desired)
+	require.NoError(t, err)
+	delta := rd.Delta(desired, latest)
+	require.True(t, delta.DifferentAt("Spec.Rotation"))
+	updated, err := rm.Update(context.Background(), desired, latest, delta)
+	require.NoError(t, err)
+
+	status := updated.RuntimeObject().(*svcapitypes.APIKey).Status
+	assert.Equal(t, "key2", *status.ID)
+	assert.Equal(t, "key1", *status.RotationStatus.PreviousID)
+	assert.Equal(t, "1", *status.RotationStatus.LastRequest)
+	assert.WithinDuration(t, time.Now().Add(time.Hour), status.RotationStatus.PreviousExpirationTime.Time, time.Minute)
+	assert.Contains(t, tr.sent, "POST /usageplans/plan1/keys")
+	secret := &corev1.Secret{}
+	require.NoError(t, kc.Get(context.Background(), client.ObjectKey{Namespace: "default", Name: "key-value"}, secret))
+	assert.Equal(t, "value-key2", string(secret.Data[apikey.DefaultSecretKey]))
+	assert.Equal(t, "key2", secret.Annotations[apikey.AnnotationAPIKeyID])
+
+	// Once the overlap period has ended, the previous key is disabled and
+	// deleted.
+	ko = updated.RuntimeObject().(*svcapitypes.APIKey).DeepCopy()
+	ko.Status.RotationStatus.PreviousExpirationTime = &metav1.Time{Time: time.Now().Add(-time.Minute)}
+	desired = rd.ResourceFromRuntimeObject(ko)
+	latest, err = rm.ReadOne(context.Background(), desired)
+	require.NoError(t, err)
+	delta = rd.Delta(desired, latest)
+	require.True(t, delta.DifferentAt("Spec.Rotation"))
+	updated, err = rm.Update(context.Background(), desired, latest, delta)
+	require.NoError(t, err)
+	assert.Nil(t, updated.RuntimeObject().(*svcapitypes.APIKey).Status.RotationStatus.PreviousID)
+	assert.Equal(t, []string{"PATCH /apikeys/key1", "DELETE /apikeys/key1"}, tr.sent[len(tr.sent)-2:])
+}
+
+func TestUpdate_RotationKeptOnError(t *testing.T) {
+	kc := fake.NewClientBuilder().Build()
+	rm, rd, tr := apiKeyManager(t, kc)
+	tr.fail = "PATCH /apikeys/key2"
+	ko := apiKey(nil)
+	ko.Annotations = map[string]string{util.AnnotationRotate: "1"}
+	ko.Spec.Rotation = &svcapitypes.APIKeyRotation{}
+	ko.Status.RotationStatus = &svcapitypes.APIKeyRotationStatus{LastRequest: aws.String("0")}
+	desired := rd.ResourceFromRuntimeObject(ko)
+
+	latest, err := rm.ReadOne(context.Background(), desired)
+	require.NoError(t, err)
+	ko = ko.DeepCopy()
+	ko.Spec.Description = aws.String("new")
+	desired = rd.ResourceFromRuntimeObject(ko)
+	delta := rd.Delta(desired, latest)
+	require.True(t, delta.DifferentAt("Spec.Rotation"))
+	require.True(t, delta.DifferentAt("Spec.Description"))
+	updated, err := rm.Update(context.Background(), desired, latest, delta)
+	require.Error(t, err)
+
+	require.NotNil(t, updated)
+	status := updated.RuntimeObject().(*svcapitypes.APIKey).Status
+	assert.Equal(t, "key2", *status.ID)
+	assert.Equal(t, "key1", *status.RotationStatus.PreviousID)
+	assert.Equal(t, "1", *status.RotationStatus.LastRequest)
+}
+
+func TestUpdate_StageKeys(t *testing.T) {
+	kc := fake.NewClientBuilder().Build()
+	rm, rd, tr := apiKeyManager(t, kc)
+	ko := apiKey(nil)
+	ko.Status.ID = aws.String("key3")
+	ko.Spec.StageKeys = []*svcapitypes.StageKey{{
+		RestAPIRef: &ackv1alpha1.AWSResourceReferenceWrapper{From: &ackv1alpha1.AWSResourceReference{Name: aws.String("api")}},
+		RestAPIID:  aws.String("api1"),
+		StageName:  aws.String("prod"),
+	}}
+	desired := rd.ResourceFromRuntimeObject(ko)
+
+	// The stage keys are the same, whatever their references.
+	latest, err := rm.ReadOne(context.Background(), desired)
+	require.NoError(t, err)
+	assert.False(t, rd.Delta(desired, latest).DifferentAt("Spec.StageKeys"))
+	assert.NotNil(t, ko.Spec.StageKeys[0].RestAPIRef)
+
+	// Removing the stage key detaches it.
+	ko.Spec.StageKeys = nil
+	latest, err = rm.ReadOne(context.Background(), desired)
+	require.NoError(t, err)
+	delta := rd.Delta(desired, latest)
+	require.True(t, delta.DifferentAt("Spec.StageKeys"))
+	_, err = rm.Update(context.Background(), desired, latest, delta)
+	require.NoError(t, err)
+	assert.Equal(t, "PATCH /apikeys/key3", tr.sent[len(tr.sent)-1])
+	assert.Contains(t, tr.bodies[len(tr.bodies)-1], `"op":"remove","path":"/stages/api1~1prod"`)
+}
@@ -0,0 +1,516 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+// Package cors maintains the OPTIONS method that answers CORS preflight
+// requests for a Resource with a spec.cors, and the CORS headers of the
+// responses of its other methods.
+package cors
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	svcsdk "github.com/aws/aws-sdk-go-v2/service/apigateway"
+	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/apigateway/types"
+
+	svcapitypes "github.com/aws-controllers-k8s/apigateway-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/apigateway-controller/pkg/util"
+	"github.com/aws-controllers-k8s/apigateway-controller/pkg/util/patch"
+)
+
+const (
+	// PreflightOperationName marks the OPTIONS methods maintained from
+	// spec.cors, so that OPTIONS methods managed otherwise are left alone.
+	PreflightOperationName = "CORSPreflight"
+	preflightStatusCode    = "200"
+)
+
+var patchKeyEncoder = strings.NewReplacer("~", "~0", "/", "~1")
+
+type metricsRecorder interface {
+	RecordAPICall(opType string, opID string, err error)
+}
+
+type corsClient interface {
+	GetResource(context.Context, *svcsdk.GetResourceInput, ...func(*svcsdk.Options)) (*svcsdk.GetResourceOutput, error)
+	PutMethod(context.Context, *svcsdk.PutMethodInput, ...func(*svcsdk.Options)) (*svcsdk.PutMethodOutput, error)
+	DeleteMethod(context.Context, *svcsdk.DeleteMethodInput, ...func(*svcsdk.Options)) (*svcsdk.DeleteMethodOutput, error)
+	PutMethodResponse(context.Context, *svcsdk.PutMethodResponseInput, ...func(*svcsdk.Options)) (*svcsdk.PutMethodResponseOutput, error)
+	UpdateMethodResponse(context.Context, *svcsdk.UpdateMethodResponseInput, ...func(*svcsdk.Options)) (*svcsdk.UpdateMethodResponseOutput, error)
+	PutIntegration(context.Context, *svcsdk.PutIntegrationInput, ...func(*svcsdk.Options)) (*svcsdk.PutIntegrationOutput, error)
+	PutIntegrationResponse(context.Context, *svcsdk.PutIntegrationResponseInput, ...func(*svcsdk.Options)) (*svcsdk.PutIntegrationResponseOutput, error)
+	UpdateIntegrationResponse(context.Context, *svcsdk.UpdateIntegrationResponseInput, ...func(*svcsdk.Options)) (*svcsdk.UpdateIntegrationResponseOutput, error)
+}
+
+// IsPreflight returns true if the method was created from spec.cors.
+func IsPreflight(method svcsdktypes.Method) bool {
+	return aws.ToString(method.OperationName) == PreflightOperationName
+}
+
+// InSync returns true if the methods of a resource match its CORS
+// configuration: with a configuration, the OPTIONS method answers with its
+// headers and the other methods return them, and without one, there is no
+// OPTIONS method maintained from spec.cors.
+func InSync(cors *svcapitypes.CORSConfiguration, methods map[string]svcsdktypes.Method) bool {
+	preflight, ok := methods[http.MethodOptions]
+	managed := ok && IsPreflight(preflight)
+	if cors == nil {
+		return !managed
+	}
+	if !managed || !preflightInSync(cors, preflight, methods) {
+		return false
+	}
+	methodResponses, integrationResponses := responsesOutOfSync(responseHeaders(cors), methods)
+	return len(methodResponses) == 0 && len(integrationResponses) == 0
+}
+
+// Sync maintains the OPTIONS method that answers CORS preflight requests for
+// a resource, and the CORS headers of the responses of its other methods. The
+// OPTIONS method is only put when it does not answer with the headers of the
+// CORS configuration, and is deleted, together with the CORS headers of the
+// other methods, when cors is nil.
+func Sync(
+	ctx context.Context,
+	client corsClient,
+	mr metricsRecorder,
+	restAPIID *string,
+	resourceID *string,
+	cors *svcapitypes.CORSConfiguration,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.syncCORS")
+	defer func() { exit(err) }()
+
+	methods, err := getMethods(ctx, client, mr, restAPIID, resourceID)
+	if err != nil {
+		return err
+	}
+	preflight, ok := methods[http.MethodOptions]
+	managed := ok && IsPreflight(preflight)
+	if ok && !managed {
+		if cors == nil {
+			return nil
+		}
+		return ackerr.NewTerminalError(errors.New("spec.cors cannot be set on a resource with an OPTIONS method"))
+	}
+	if cors == nil {
+		if !managed {
+			return nil
+		}
+		if err := syncResponseHeaders(ctx, client, mr, restAPIID, resourceID, nil, methods); err != nil {
+			return err
+		}
+		_, err = client.DeleteMethod(ctx, &svcsdk.DeleteMethodInput{
+			RestApiId:  restAPIID,
+			ResourceId: resourceID,
+			HttpMethod: aws.String(http.MethodOptions),
+		})
+		mr.RecordAPICall("DELETE", "DeleteMethod", err)
+		return err
+	}
+	if !managed || !preflightInSync(cors, preflight, methods) {
+		rlog.Debug("putting CORS preflight method")
+		var existing *svcsdktypes.Method
+		if managed {
+			existing = &preflight
+		}
+		expected := util.NewCORSPreflight(cors, httpMethods(methods))
+		if err := putPreflight(ctx, client, mr, restAPIID, resourceID, expected, existing); err != nil {
+			return err
+		}
+	}
+	return syncResponseHeaders(ctx, client, mr, restAPIID, resourceID, responseHeaders(cors), methods)
+}
+
+// SyncMethod updates the CORS preflight maintained from spec.cors for a
+// resource, if it has one, after its method httpMethod, or a response of it,
+// was put, or the method was deleted. The Allow-Methods header follows the
+// methods of the resource unless spec.cors lists them, and the responses of
+// the method get the CORS headers that the preflight response maps.
+func SyncMethod(
+	ctx context.Context,
+	client corsClient,
+	mr metricsRecorder,
+	restAPIID *string,
+	resourceID *string,
+	httpMethod string,
+	deleted bool,
+) (err error) {
+	if httpMethod == http.MethodOptions {
+		return nil
+	}
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.syncMethodCORS")
+	defer func() { exit(err) }()
+
+	methods, err := getMethods(ctx, client, mr, restAPIID, resourceID)
+	if err != nil {
+		return err
+	}
+	preflight, ok := methods[http.MethodOptions]
+	if !ok || !IsPreflight(preflight) || preflight.MethodIntegration == nil {
+		return nil
+	}
+	response, ok := preflight.MethodIntegration.IntegrationResponses[preflightStatusCode]
+	if !ok {
+		return nil
+	}
+
+	current := httpMethods(methods)
+	previous := slices.DeleteFunc(slices.Clone(current), func(m string) bool { return m == httpMethod })
+	if deleted {
+		previous = append(previous, httpMethod)
+		slices.Sort(previous)
+	}
+	allowMethods := util.ResponseHeaderParameter(util.CORSAllowMethodsHeader)
+	value := response.ResponseParameters[allowMethods]
+	if want := defaultAllowMethods(current); value == defaultAllowMethods(previous) && value != want {
+		var patchSet patch.Set
+		patchSet.Replace(fmt.Sprintf("/responseParameters/%s", patchKeyEncoder.Replace(allowMethods)), aws.String(want))
+		_, err := client.UpdateIntegrationResponse(ctx, &svcsdk.UpdateIntegrationResponseInput{
+			RestApiId:       restAPIID,
+			ResourceId:      resourceID,
+			HttpMethod:      aws.String(http.MethodOptions),
+			StatusCode:      aws.String(preflightStatusCode),
+			PatchOperations: patchSet.GetPatchOperations(),
+		})
+		mr.RecordAPICall("UPDATE", "UpdateIntegrationResponse", err)
+		if err != nil {
+			return err
+		}
+	}
+
+	if deleted {
+		return nil
+	}
+	// The preflight response maps the Allow-Origin header to a static value
+	// exactly when the other methods return the CORS headers.
+	var headers map[string]string
+	if origin, ok := response.ResponseParameters[util.ResponseHeaderParameter(util.CORSAllowOriginHeader)]; ok {
+		headers = map[string]string{util.ResponseHeaderParameter(util.CORSAllowOriginHeader): origin}
+		credentials := util.ResponseHeaderParameter(util.CORSAllowCredentialsHeader)
+		if v, ok := response.ResponseParameters[credentials]; ok {
+			headers[credentials] = v
+		}
+	}
+	method := map[string]svcsdktypes.Method{httpMethod: methods[httpMethod]}
+	return syncResponseHeaders(ctx, client, mr, restAPIID, resourceID, headers, method)
+}
+
+// getMethods returns the methods of a resource.
+func getMethods(
+	ctx context.Context,
+	client corsClient,
+	mr metricsRecorder,
+	restAPIID *string,
+	resourceID *string,
+) (map[string]svcsdktypes.Method, error) {
+	resp, err := client.GetResource(ctx, &svcsdk.GetResourceInput{
+		RestApiId:  restAPIID,
+		ResourceId: resourceID,
+		Embed:      []string{"methods"},
+	})
+	mr.RecordAPICall("READ_ONE", "GetResource", err)
+	if err != nil {
+		return nil, err
+	}
+	return resp.ResourceMethods, nil
+}
+
+// httpMethods returns the HTTP methods of a resource other than OPTIONS.
+func httpMethods(methods map[string]svcsdktypes.Method) []string {
+	var httpMethods []string
+	for httpMethod := range methods {
+		if httpMethod != http.MethodOptions {
+			httpMethods = append(httpMethods, httpMethod)
+		}
+	}
+	slices.Sort(httpMethods)
+	return httpMethods
+}
+
+// defaultAllowMethods returns the value that the preflight response of a
+// resource whose methods are httpMethods maps to the Allow-Methods header
+// when spec.cors does not list the allowed methods.
+func defaultAllowMethods(httpMethods []string) string {
+	preflight := util.NewCORSPreflight(&svcapitypes.CORSConfiguration{}, httpMethods)
+	return preflight.IntegrationResponseParameters[util.ResponseHeaderParameter(util.CORSAllowMethodsHeader)]
+}
+
+// preflightInSync returns true if the preflight method answers with the
+// headers of the CORS configuration.
+func preflightInSync(
+	cors *svcapitypes.CORSConfiguration,
+	preflight svcsdktypes.Method,
+	methods map[string]svcsdktypes.Method,
+) bool {
+	integration := preflight.MethodIntegration
+	if integration == nil || integration.Type != svcsdktypes.IntegrationTypeMock {
+		return false
+	}
+	expected := util.NewCORSPreflight(cors, httpMethods(methods))
+	methodResponse := preflight.MethodResponses[preflightStatusCode]
+	integrationResponse := integration.IntegrationResponses[preflightStatusCode]
+	return maps.Equal(methodResponse.ResponseParameters, expected.MethodResponseParameters) &&
+		maps.Equal(integrationResponse.ResponseParameters, expected.IntegrationResponseParameters) &&
+		maps.Equal(integrationResponse.ResponseTemplates, expected.IntegrationResponseTemplates)
+}
+
+// responseHeaders returns the values that the integration responses of the
+// methods of a resource map to CORS headers. The Allow-Origin header can only
+// be mapped to a static value when a single origin is allowed.
+func responseHeaders(cors *svcapitypes.CORSConfiguration) map[string]string {
+	origin, ok := util.CORSAllowOrigin(cors)
+	if !ok {
+		return nil
+	}
+	headers := map[string]string{
+		util.ResponseHeaderParameter(util.CORSAllowOriginHeader): origin,
+	}
+	if aws.ToBool(cors.AllowCredentials) {
+		headers[util.ResponseHeaderParameter(util.CORSAllowCredentialsHeader)] = "'true'"
+	}
+	return headers
+}
+
+// methodResponseKey identifies a response of a method of a resource.
+type methodResponseKey struct {
+	httpMethod string
+	statusCode string
+}
+
+// responsesOutOfSync returns the method responses and integration responses
+// of the methods of a resource other than OPTIONS whose CORS headers differ
+// from headers: the method responses must declare exactly the headers, and
+// the integration responses must map exactly the headers to their values.
+func responsesOutOfSync(
+	headers map[string]string,
+	methods map[string]svcsdktypes.Method,
+) (methodResponses, integrationResponses []methodResponseKey) {
+	for httpMethod, method := range methods {
+		if httpMethod == http.MethodOptions || method.MethodIntegration == nil {
+			continue
+		}
+		switch method.MethodIntegration.Type {
+		case svcsdktypes.IntegrationTypeAwsProxy, svcsdktypes.IntegrationTypeHttpProxy:
+			// Proxy integrations return the response headers of the backend.
+			continue
+		}
+		for statusCode, response := range method.MethodResponses {
+			if len(methodResponsePatch(headers, response.ResponseParameters).GetPatchOperations()) > 0 {
+				methodResponses = append(methodResponses, methodResponseKey{httpMethod, statusCode})
+			}
+		}
+		for statusCode, response := range method.MethodIntegration.IntegrationResponses {
+			if len(integrationResponsePatch(headers, response.ResponseParameters).GetPatchOperations()) > 0 {
+				integrationResponses = append(integrationResponses, methodResponseKey{httpMethod, statusCode})
+			}
+		}
+	}
+	return methodResponses, integrationResponses
+}
+
+// methodResponsePatch returns the patch operations declaring the CORS headers
+// in headers on a method response, and removing the other ones.
+func methodResponsePatch(headers map[string]string, current map[string]bool) *patch.Set {
+	var patchSet patch.Set
+	for _, header := range util.CORSResponseHeaders {
+		parameter := util.ResponseHeaderParameter(header)
+		path := fmt.Sprintf("/responseParameters/%s", patchKeyEncoder.Replace(parameter))
+		_, want := headers[parameter]
+		_, have := current[parameter]
+		switch {
+		case want && !have:
+			patchSet.Add(path, aws.String("false"))
+		case !want && have:
+			patchSet.Remove(path, nil)
+		}
+	}
+	return &patchSet
+}
+
+// integrationResponsePatch returns the patch operations mapping the CORS
+// headers in headers to their values on an integration response, and
+// removing the other ones.
+func integrationResponsePatch(headers map[string]string, current map[string]string) *patch.Set {
+	var patchSet patch.Set
+	for _, header := range util.CORSResponseHeaders {
+		parameter := util.ResponseHeaderParameter(header)
+		path := fmt.Sprintf("/responseParameters/%s", patchKeyEncoder.Replace(parameter))
+		value, want := headers[parameter]
+		currentValue, have := current[parameter]
+		switch {
+		case want && !have:
+			patchSet.Add(path, aws.String(value))
+		case want && currentValue != value:
+			patchSet.Replace(path, aws.String(value))
+		case !want && have:
+			patchSet.Remove(path, nil)
+		}
+	}
+	return &patchSet
+}
+
+// syncResponseHeaders declares the CORS headers in headers on the method
+// responses of the methods of a resource other than OPTIONS and maps them in
+// their integration responses, and removes the other CORS headers.
+func syncResponseHeaders(
+	ctx context.Context,
+	client corsClient,
+	mr metricsRecorder,
+	restAPIID *string,
+	resourceID *string,
+	headers map[string]string,
+	methods map[string]svcsdktypes.Method,
+) error {
+	methodResponses, integrationResponses := responsesOutOfSync(headers, methods)
+	for _, key := range methodResponses {
+		current := methods[key.httpMethod].MethodResponses[key.statusCode].ResponseParameters
+		_, err := client.UpdateMethodResponse(ctx, &svcsdk.UpdateMethodResponseInput{
+			RestApiId:       restAPIID,
+			ResourceId:      resourceID,
+			HttpMethod:      aws.String(key.httpMethod),
+			StatusCode:      aws.String(key.statusCode),
+			PatchOperations: methodResponsePatch(headers, current).GetPatchOperations(),
+		})
+		mr.RecordAPICall("UPDATE", "UpdateMethodResponse", err)
+		if err != nil {
+			return err
+		}
+	}
+	for _, key := range integrationResponses {
+		current := methods[key.httpMethod].MethodIntegration.IntegrationResponses[key.statusCode].ResponseParameters
+		_, err := client.UpdateIntegrationResponse(ctx, &svcsdk.UpdateIntegrationResponseInput{
+			RestApiId:       restAPIID,
+			ResourceId:      resourceID,
+			HttpMethod:      aws.String(key.httpMethod),
+			StatusCode:      aws.String(key.statusCode),
+			PatchOperations: integrationResponsePatch(headers, current).GetPatchOperations(),
+		})
+		mr.RecordAPICall("UPDATE", "UpdateIntegrationResponse", err)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// putPreflight puts the OPTIONS method of a resource with a MOCK integration
+// that returns the preflight response. When the resource already has the
+// OPTIONS method, existing is that method: the declared headers of its
+// response are patched, and its integration and integration response are
+// replaced.
+func putPreflight(
+	ctx context.Context,
+	client corsClient,
+	mr metricsRecorder,
+	restAPIID *string,
+	resourceID *string,
+	preflight *util.CORSPreflight,
+	existing *svcsdktypes.Method,
+) error {
+	if existing == nil {
+		_, err := client.PutMethod(ctx, &svcsdk.PutMethodInput{
+			RestApiId:         restAPIID,
+			ResourceId:        resourceID,
+			HttpMethod:        aws.String(http.MethodOptions),
+			AuthorizationType: aws.String("NONE"),
+			OperationName:     aws.String(PreflightOperationName),
+		})
+		mr.RecordAPICall("CREATE", "PutMethod", err)
+		if err != nil {
+			return err
+		}
+	}
+	if response, ok := existingMethodResponse(existing); ok {
+		if !maps.Equal(response.ResponseParameters, preflight.MethodResponseParameters) {
+			var patchSet patch.Set
+			patchSet.ForMap("/responseParameters", boolValues(response.ResponseParameters),
+				boolValues(preflight.MethodResponseParameters), true)
+			_, err := client.UpdateMethodResponse(ctx, &svcsdk.UpdateMethodResponseInput{
+				RestApiId:       restAPIID,
+				ResourceId:      resourceID,
+				HttpMethod:      aws.String(http.MethodOptions),
+				StatusCode:      aws.String(preflightStatusCode),
+				PatchOperations: patchSet.GetPatchOperations(),
+			})
+			mr.RecordAPICall("UPDATE", "UpdateMethodResponse", err)
+			if err != nil {
+				return err
+			}
+		}
+	} else {
+		_, err := client.PutMethodResponse(ctx, &svcsdk.PutMethodResponseInput{
+			RestApiId:          restAPIID,
+			ResourceId:         resourceID,
+			HttpMethod:         aws.String(http.MethodOptions),
+			StatusCode:         aws.String(preflightStatusCode),
+			ResponseParameters: preflight.MethodResponseParameters,
+			ResponseModels:     map[string]string{"application/json": "Empty"},
+		})
+		mr.RecordAPICall("CREATE", "PutMethodResponse", err)
+		if err != nil {
+			return err
+		}
+	}
+	_, err := client.PutIntegration(ctx, &svcsdk.PutIntegrationInput{
+		RestApiId:        restAPIID,
+		ResourceId:       resourceID,
+		HttpMethod:       aws.String(http.MethodOptions),
+		Type:             svcsdktypes.IntegrationTypeMock,
+		RequestTemplates: map[string]string{"application/json": `{"statusCode": 200}`},
+	})
+	mr.RecordAPICall("CREATE", "PutIntegration", err)
+	if err != nil {
+		return err
+	}
+	_, err = client.PutIntegrationResponse(ctx, &svcsdk.PutIntegrationResponseInput{
+		RestApiId:          restAPIID,
+		ResourceId:         resourceID,
+		HttpMethod:         aws.String(http.MethodOptions),
+		StatusCode:         aws.String(preflightStatusCode),
+		ResponseParameters: preflight.IntegrationResponseParameters,
+		ResponseTemplates:  preflight.IntegrationResponseTemplates,
+	})
+	mr.RecordAPICall("CREATE", "PutIntegrationResponse", err)
+	return err
+}
+
+// existingMethodResponse returns the preflight response of an existing
+// OPTIONS method.
+func existingMethodResponse(existing *svcsdktypes.Method) (svcsdktypes.MethodResponse, bool) {
+	if existing == nil {
+		return svcsdktypes.MethodResponse{}, false
+	}
+	response, ok := existing.MethodResponses[preflightStatusCode]
+	return response, ok
+}
+
+// boolValues returns the patch values of the declared headers of a method
+// response.
+func boolValues(parameters map[string]bool) map[string]*string {
+	values := make(map[string]*string, len(parameters))
+	for parameter, required := range parameters {
+		values[parameter] = aws.String(strconv.FormatBool(required))
+	}
+	return values
+}
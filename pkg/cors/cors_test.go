@@ -0,0 +1,217 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package cors_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	svcsdk "github.com/aws/aws-sdk-go-v2/service/apigateway"
+	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/apigateway/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	svcapitypes "github.com/aws-controllers-k8s/apigateway-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/apigateway-controller/pkg/cors"
+	"github.com/aws-controllers-k8s/apigateway-controller/pkg/util"
+)
+
+type metrics struct{}
+
+func (metrics) RecordAPICall(string, string, error) {}
+
+// client answers GetResource with methods and records the other calls sent
+// with the HTTP method they target.
+type client struct {
+	methods map[string]svcsdktypes.Method
+	sent    []string
+}
+
+func (c *client) GetResource(context.Context, *svcsdk.GetResourceInput, ...func(*svcsdk.Options)) (*svcsdk.GetResourceOutput, error) {
+	return &svcsdk.GetResourceOutput{ResourceMethods: c.methods}, nil
+}
+
+func (c *client) PutMethod(_ context.Context, in *svcsdk.PutMethodInput, _ ...func(*svcsdk.Options)) (*svcsdk.PutMethodOutput, error) {
+	c.sent = append(c.sent, "PutMethod "+aws.ToString(in.HttpMethod))
+	return &svcsdk.PutMethodOutput{}, nil
+}
+
+func (c *client) DeleteMethod(_ context.Context, in *svcsdk.DeleteMethodInput, _ ...func(*svcsdk.Options)) (*svcsdk.DeleteMethodOutput, error) {
+	c.sent = append(c.sent, "DeleteMethod "+aws.ToString(in.HttpMethod))
+	return &svcsdk.DeleteMethodOutput{}, nil
+}
+
+func (c *client) PutMethodResponse(_ context.Context, in *svcsdk.PutMethodResponseInput, _ ...func(*svcsdk.Options)) (*svcsdk.PutMethodResponseOutput, error) {
+	c.sent = append(c.sent, "PutMethodResponse "+aws.ToString(in.HttpMethod))
+	return &svcsdk.PutMethodResponseOutput{}, nil
+}
+
+func (c *client) UpdateMethodResponse(_ context.Context, in *svcsdk.UpdateMethodResponseInput, _ ...func(*svcsdk.Options)) (*svcsdk.UpdateMethodResponseOutput, error) {
+	c.sent = append(c.sent, "UpdateMethodResponse "+aws.ToString(in.HttpMethod))
+	return &svcsdk.UpdateMethodResponseOutput{}, nil
+}
+
+func (c *client) PutIntegration(_ context.Context, in *svcsdk.PutIntegrationInput, _ ...func(*svcsdk.Options)) (*svcsdk.PutIntegrationOutput, error) {
+	c.sent = append(c.sent, "PutIntegration "+aws.ToString(in.HttpMethod))
+	return &svcsdk.PutIntegrationOutput{}, nil
+}
+
+func (c *client) PutIntegrationResponse(_ context.Context, in *svcsdk.PutIntegrationResponseInput, _ ...func(*svcsdk.Options)) (*svcsdk.PutIntegrationResponseOutput, error) {
+	c.sent = append(c.sent, "PutIntegrationResponse "+aws.ToString(in.HttpMethod))
+	return &svcsdk.PutIntegrationResponseOutput{}, nil
+}
+
+func (c *client) UpdateIntegrationResponse(_ context.Context, in *svcsdk.UpdateIntegrationResponseInput, _ ...func(*svcsdk.Options)) (*svcsdk.UpdateIntegrationResponseOutput, error) {
+	c.sent = append(c.sent, "UpdateIntegrationResponse "+aws.ToString(in.HttpMethod))
+	return &svcsdk.UpdateIntegrationResponseOutput{}, nil
+}
+
+var (
+	allowOrigin = util.ResponseHeaderParameter(util.CORSAllowOriginHeader)
+	testCORS    = &svcapitypes.CORSConfiguration{
+		AllowOrigins: aws.StringSlice([]string{"https://example.com"}),
+	}
+)
+
+// method returns a method with a non-proxy integration whose 200 response
+// returns the Allow-Origin header when withHeaders is true.
+func method(withHeaders bool) svcsdktypes.Method {
+	methodResponse := svcsdktypes.MethodResponse{}
+	integrationResponse := svcsdktypes.IntegrationResponse{}
+	if withHeaders {
+		methodResponse.ResponseParameters = map[string]bool{allowOrigin: false}
+		integrationResponse.ResponseParameters = map[string]string{allowOrigin: "'https://example.com'"}
+	}
+	return svcsdktypes.Method{
+		MethodResponses: map[string]svcsdktypes.MethodResponse{"200": methodResponse},
+		MethodIntegration: &svcsdktypes.Integration{
+			Type:                 svcsdktypes.IntegrationTypeAws,
+			IntegrationResponses: map[string]svcsdktypes.IntegrationResponse{"200": integrationResponse},
+		},
+	}
+}
+
+// preflight returns the OPTIONS method maintained from the CORS
+// configuration for a resource whose methods are httpMethods.
+func preflight(config *svcapitypes.CORSConfiguration, httpMethods ...string) svcsdktypes.Method {
+	expected := util.NewCORSPreflight(config, httpMethods)
+	return svcsdktypes.Method{
+		OperationName: aws.String(cors.PreflightOperationName),
+		MethodResponses: map[string]svcsdktypes.MethodResponse{
+			"200": {ResponseParameters: expected.MethodResponseParameters},
+		},
+		MethodIntegration: &svcsdktypes.Integration{
+			Type: svcsdktypes.IntegrationTypeMock,
+			IntegrationResponses: map[string]svcsdktypes.IntegrationResponse{
+				"200": {
+					ResponseParameters: expected.IntegrationResponseParameters,
+					ResponseTemplates:  expected.IntegrationResponseTemplates,
+				},
+			},
+		},
+	}
+}
+
+func TestSync_InSync(t *testing.T) {
+	c := &client{methods: map[string]svcsdktypes.Method{
+		"GET":     method(true),
+		"OPTIONS": preflight(testCORS, "GET"),
+	}}
+
+	assert.True(t, cors.InSync(testCORS, c.methods))
+	require.NoError(t, cors.Sync(context.TODO(), c, metrics{}, aws.String("api"), aws.String("res"), testCORS))
+	assert.Empty(t, c.sent)
+}
+
+func TestSync_UpdatesPreflight(t *testing.T) {
+	c := &client{methods: map[string]svcsdktypes.Method{
+		"GET":     method(true),
+		"OPTIONS": preflight(testCORS, "GET"),
+	}}
+	config := testCORS.DeepCopy()
+	config.MaxAge = aws.Int64(600)
+
+	assert.False(t, cors.InSync(config, c.methods))
+	require.NoError(t, cors.Sync(context.TODO(), c, metrics{}, aws.String("api"), aws.String("res"), config))
+	assert.Equal(t, []string{
+		"UpdateMethodResponse OPTIONS",
+		"PutIntegration OPTIONS",
+		"PutIntegrationResponse OPTIONS",
+	}, c.sent)
+}
+
+func TestSync_RemovesHeaders(t *testing.T) {
+	c := &client{methods: map[string]svcsdktypes.Method{
+		"GET":     method(true),
+		"OPTIONS": preflight(testCORS, "GET"),
+	}}
+
+	assert.False(t, cors.InSync(nil, c.methods))
+	require.NoError(t, cors.Sync(context.TODO(), c, metrics{}, aws.String("api"), aws.String("res"), nil))
+	assert.Equal(t, []string{
+		"UpdateMethodResponse GET",
+		"UpdateIntegrationResponse GET",
+		"DeleteMethod OPTIONS",
+	}, c.sent)
+}
+
+func TestSync_UnmanagedOptions(t *testing.T) {
+	c := &client{methods: map[string]svcsdktypes.Method{
+		"OPTIONS": method(false),
+	}}
+
+	assert.True(t, cors.InSync(nil, c.methods))
+	assert.NoError(t, cors.Sync(context.TODO(), c, metrics{}, aws.String("api"), aws.String("res"), nil))
+	assert.Error(t, cors.Sync(context.TODO(), c, metrics{}, aws.String("api"), aws.String("res"), testCORS))
+	assert.Empty(t, c.sent)
+}
+
+func TestSyncMethod_Created(t *testing.T) {
+	c := &client{methods: map[string]svcsdktypes.Method{
+		"GET":     method(true),
+		"POST":    method(false),
+		"OPTIONS": preflight(testCORS, "GET"),
+	}}
+
+	require.NoError(t, cors.SyncMethod(context.TODO(), c, metrics{}, aws.String("api"), aws.String("res"), "POST", false))
+	assert.Equal(t, []string{
+		"UpdateIntegrationResponse OPTIONS",
+		"UpdateMethodResponse POST",
+		"UpdateIntegrationResponse POST",
+	}, c.sent)
+}
+
+func TestSyncMethod_Deleted(t *testing.T) {
+	c := &client{methods: map[string]svcsdktypes.Method{
+		"GET":     method(true),
+		"OPTIONS": preflight(testCORS, "GET", "POST"),
+	}}
+
+	require.NoError(t, cors.SyncMethod(context.TODO(), c, metrics{}, aws.String("api"), aws.String("res"), "POST", true))
+	assert.Equal(t, []string{"UpdateIntegrationResponse OPTIONS"}, c.sent)
+}
+
+func TestSyncMethod_AllowMethodsListed(t *testing.T) {
+	config := testCORS.DeepCopy()
+	config.AllowMethods = aws.StringSlice([]string{"GET"})
+	c := &client{methods: map[string]svcsdktypes.Method{
+		"GET":     method(true),
+		"POST":    method(true),
+		"OPTIONS": preflight(config, "GET"),
+	}}
+
+	require.NoError(t, cors.SyncMethod(context.TODO(), c, metrics{}, aws.String("api"), aws.String("res"), "POST", false))
+	assert.Empty(t, c.sent)
+}
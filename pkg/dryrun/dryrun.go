@@ -0,0 +1,360 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+// Package dryrun implements the dry-run mode of the controller, in which the
+// API Gateway calls that would create, update or delete resources are
+// computed and reported, but not sent.
+package dryrun
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+	ackcondition "github.com/aws-controllers-k8s/runtime/pkg/condition"
+	ackcfg "github.com/aws-controllers-k8s/runtime/pkg/config"
+	ackmetrics "github.com/aws-controllers-k8s/runtime/pkg/metrics"
+	ackrequeue "github.com/aws-controllers-k8s/runtime/pkg/requeue"
+	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
+	acktypes "github.com/aws-controllers-k8s/runtime/pkg/types"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	svcsdk "github.com/aws/aws-sdk-go-v2/service/apigateway"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/aws-controllers-k8s/apigateway-controller/pkg/util"
+)
+
+// AnnotationDryRun is the annotation enabling ("true") or disabling ("false")
+// the dry-run mode for a single resource, whatever the mode of the
+// controller.
+const AnnotationDryRun = "apigateway.services.k8s.aws/dry-run"
+
+// ConditionReasonDryRun is the reason of the ResourceSynced condition of a
+// resource whose pending API calls were not sent.
+const ConditionReasonDryRun = "DryRun"
+
+// RequeueAfter is the delay after which a resource in dry-run mode is
+// reconciled again, so that its pending API calls follow changes made
+// outside of the controller.
+const RequeueAfter = 5 * time.Minute
+
+// PendingID is the identifier of the resources that withheld calls would
+// have created, so that the calls that follow on them are computed too.
+const PendingID = "dry-run-pending"
+
+// redacted replaces the secrets of the inputs of withheld calls.
+const redacted = "REDACTED"
+
+// ErrDryRun is returned, wrapped in a requeue error, by the resource managers
+// when the API calls of a resource were not sent.
+var ErrDryRun = errors.New("dry run: API calls not sent")
+
+// secretFields are the fields of the inputs of API Gateway operations that
+// carry secrets, which are not reported.
+var secretFields = map[string][]string{
+	"CreateApiKey":  {"Value"},
+	"ImportApiKeys": {"Body"},
+}
+
+// Call is an API Gateway call withheld in dry-run mode.
+type Call struct {
+	Operation string          `json:"operation"`
+	Input     json.RawMessage `json:"input"`
+}
+
+type recorderKey struct{}
+
+// recorder collects the calls withheld while a resource is reconciled.
+type recorder struct {
+	mu    sync.Mutex
+	calls []Call
+}
+
+func (r *recorder) record(operation string, input interface{}) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.calls = append(r.calls, Call{Operation: operation, Input: compactInput(operation, input)})
+}
+
+// Enabled returns whether the API calls of a resource are withheld, given the
+// mode of the controller.
+func Enabled(res acktypes.AWSResource, controllerDefault bool) bool {
+	if v, ok := res.MetaObject().GetAnnotations()[AnnotationDryRun]; ok {
+		if enabled, err := strconv.ParseBool(v); err == nil {
+			return enabled
+		}
+	}
+	return controllerDefault
+}
+
+// WrapManagerFactories returns resource manager factories whose managers
+// withhold the mutating API calls of the resources in dry-run mode. enabled
+// is the mode of the controller, which resources override with the
+// AnnotationDryRun annotation.
+func WrapManagerFactories(
+	factories []acktypes.AWSResourceManagerFactory,
+	enabled bool,
+) []acktypes.AWSResourceManagerFactory {
+	wrapped := make([]acktypes.AWSResourceManagerFactory, 0, len(factories))
+	for _, f := range factories {
+		wrapped = append(wrapped, &managerFactory{AWSResourceManagerFactory: f, enabled: enabled})
+	}
+	return wrapped
+}
+
+type managerFactory struct {
+	acktypes.AWSResourceManagerFactory
+	enabled bool
+}
+
+// ManagerFor returns a resource manager whose API Gateway client withholds
+// the mutating calls made on behalf of resources in dry-run mode.
+func (f *managerFactory) ManagerFor(
+	cfg ackcfg.Config,
+	clientcfg aws.Config,
+	log logr.Logger,
+	metrics *ackmetrics.Metrics,
+	rr acktypes.Reconciler,
+	id ackv1alpha1.AWSAccountID,
+	region ackv1alpha1.AWSRegion,
+	roleARN ackv1alpha1.AWSResourceName,
+) (acktypes.AWSResourceManager, error) {
+	clientcfg.APIOptions = append(slices.Clone(clientcfg.APIOptions), addMiddleware)
+	rm, err := f.AWSResourceManagerFactory.ManagerFor(cfg, clientcfg, log, metrics, rr, id, region, roleARN)
+	if err != nil {
+		return nil, err
+	}
+	return &resourceManager{AWSResourceManager: rm, enabled: f.enabled}, nil
+}
+
+type resourceManager struct {
+	acktypes.AWSResourceManager
+	enabled bool
+}
+
+// ReadOne returns, for a resource being deleted in dry-run mode, the spec of
+// the resource with the observed status. The runtime patches the spec of the
+// resource returned by Delete, which must not be replaced by the observed one
+// since the resource is not deleted.
+func (rm *resourceManager) ReadOne(
+	ctx context.Context,
+	res acktypes.AWSResource,
+) (acktypes.AWSResource, error) {
+	observed, err := rm.AWSResourceManager.ReadOne(ctx, res)
+	if err != nil || !res.IsBeingDeleted() || !Enabled(res, rm.enabled) {
+		return observed, err
+	}
+	kept := res.DeepCopy()
+	kept.SetStatus(observed)
+	return kept, nil
+}
+
+func (rm *resourceManager) Create(
+	ctx context.Context,
+	res acktypes.AWSResource,
+) (acktypes.AWSResource, error) {
+	if !Enabled(res, rm.enabled) {
+		return rm.AWSResourceManager.Create(ctx, res)
+	}
+	ctx, rec := withRecorder(ctx)
+	if created, err := rm.AWSResourceManager.Create(ctx, res); err != nil {
+		return created, err
+	}
+	return report(ctx, res.DeepCopy(), rec)
+}
+
+func (rm *resourceManager) Update(
+	ctx context.Context,
+	desired acktypes.AWSResource,
+	latest acktypes.AWSResource,
+	delta *ackcompare.Delta,
+) (acktypes.AWSResource, error) {
+	if !Enabled(desired, rm.enabled) {
+		return rm.AWSResourceManager.Update(ctx, desired, latest, delta)
+	}
+	ctx, rec := withRecorder(ctx)
+	if updated, err := rm.AWSResourceManager.Update(ctx, desired, latest, delta); err != nil {
+		return updated, err
+	}
+	return report(ctx, latest.DeepCopy(), rec)
+}
+
+// Delete reports the calls deleting a resource in dry-run mode and returns an
+// error, so that the resource keeps its finalizer.
+func (rm *resourceManager) Delete(
+	ctx context.Context,
+	res acktypes.AWSResource,
+) (acktypes.AWSResource, error) {
+	if !Enabled(res, rm.enabled) {
+		return rm.AWSResourceManager.Delete(ctx, res)
+	}
+	ctx, rec := withRecorder(ctx)
+	if deleted, err := rm.AWSResourceManager.Delete(ctx, res); err != nil {
+		return deleted, err
+	}
+	return report(ctx, res.DeepCopy(), rec)
+}
+
+// report writes the withheld calls in the ResourceSynced condition of a
+// resource. The returned error requeues the resource and keeps the runtime
+// from treating the calls as done.
+func report(
+	ctx context.Context,
+	res acktypes.AWSResource,
+	rec *recorder,
+) (acktypes.AWSResource, error) {
+	rec.mu.Lock()
+	calls := rec.calls
+	rec.mu.Unlock()
+	if calls == nil {
+		calls = []Call{}
+	}
+	// Marshaling raw JSON messages cannot fail.
+	out, _ := json.Marshal(calls)
+	message := ErrDryRun.Error() + ": " + string(out)
+	reason := ConditionReasonDryRun
+	ackcondition.SetSynced(res, corev1.ConditionFalse, &message, &reason)
+	ackrtlog.FromContext(ctx).Info("dry run: API calls not sent", "calls", string(out))
+	return res, ackrequeue.NeededAfter(ErrDryRun, RequeueAfter)
+}
+
+func withRecorder(ctx context.Context) (context.Context, *recorder) {
+	rec := &recorder{}
+	return context.WithValue(ctx, recorderKey{}, rec), rec
+}
+
+// addMiddleware adds to the middleware stack of the API Gateway client the
+// middleware withholding the mutating calls made in dry-run mode. The calls
+// are recorded and answered with a stub output instead of being sent, before
+// the middlewares that lock REST APIs or wait for the rate limits, so that
+// the resource managers carry on and compute the calls that follow. Read
+// calls on resources that would have been created are answered the same
+// way, without being recorded.
+func addMiddleware(stack *middleware.Stack) error {
+	withhold := middleware.InitializeMiddlewareFunc("DryRunWithhold", func(
+		ctx context.Context,
+		in middleware.InitializeInput,
+		next middleware.InitializeHandler,
+	) (middleware.InitializeOutput, middleware.Metadata, error) {
+		rec, ok := ctx.Value(recorderKey{}).(*recorder)
+		if !ok {
+			return next.HandleInitialize(ctx, in)
+		}
+		operation := awsmiddleware.GetOperationName(ctx)
+		read := util.IsReadOperation(operation)
+		if read && !refersToPending(in.Parameters) {
+			return next.HandleInitialize(ctx, in)
+		}
+		result, ok := stubOutput(operation, in.Parameters)
+		if !ok {
+			return next.HandleInitialize(ctx, in)
+		}
+		if !read {
+			rec.record(operation, in.Parameters)
+		}
+		return middleware.InitializeOutput{Result: result}, middleware.Metadata{}, nil
+	})
+	return stack.Initialize.Add(withhold, middleware.After)
+}
+
+// refersToPending returns whether the input of a call refers to a resource
+// that a withheld call would have created.
+func refersToPending(input interface{}) bool {
+	raw, err := json.Marshal(input)
+	return err == nil && strings.Contains(string(raw), PendingID)
+}
+
+// stubOutput returns the output answering a withheld call: the fields of the
+// output of the operation are set from the fields of the same name of its
+// input, as API Gateway echoes them, and its identifier is PendingID.
+func stubOutput(operation string, input interface{}) (interface{}, bool) {
+	method, ok := reflect.TypeOf(&svcsdk.Client{}).MethodByName(operation)
+	if !ok || method.Type.NumOut() != 2 || method.Type.Out(0).Kind() != reflect.Pointer {
+		return nil, false
+	}
+	out := reflect.New(method.Type.Out(0).Elem())
+	in := reflect.Indirect(reflect.ValueOf(input))
+	for i := 0; i < out.Elem().NumField(); i++ {
+		field := out.Elem().Type().Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		if in.Kind() == reflect.Struct {
+			if v := in.FieldByName(field.Name); v.IsValid() && v.Type() == field.Type {
+				out.Elem().Field(i).Set(v)
+			}
+		}
+		if field.Name == "Id" && field.Type == reflect.TypeOf((*string)(nil)) && out.Elem().Field(i).IsNil() {
+			out.Elem().Field(i).Set(reflect.ValueOf(aws.String(PendingID)))
+		}
+	}
+	return out.Interface(), true
+}
+
+// compactInput returns the JSON encoding of the input of a call, without
+// its unset fields and with its secrets redacted.
+func compactInput(operation string, input interface{}) json.RawMessage {
+	raw, err := json.Marshal(input)
+	if err != nil {
+		return json.RawMessage(strconv.Quote(err.Error()))
+	}
+	var v interface{}
+	if err := json.Unmarshal(raw, &v); err != nil {
+		return raw
+	}
+	if fields, ok := v.(map[string]interface{}); ok {
+		for _, name := range secretFields[operation] {
+			if _, ok := fields[name]; ok {
+				fields[name] = redacted
+			}
+		}
+	}
+	out, _ := json.Marshal(dropEmpty(v))
+	return out
+}
+
+func dropEmpty(v interface{}) interface{} {
+	switch t := v.(type) {
+	case map[string]interface{}:
+		for k, e := range t {
+			e = dropEmpty(e)
+			if e == nil {
+				delete(t, k)
+			} else {
+				t[k] = e
+			}
+		}
+		if len(t) == 0 {
+			return nil
+		}
+	case []interface{}:
+		for i, e := range t {
+			t[i] = dropEmpty(e)
+		}
+		if len(t) == 0 {
+			return nil
+		}
+	}
+	return v
+}
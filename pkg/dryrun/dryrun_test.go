@@ -0,0 +1,202 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package dryrun_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	ackcondition "github.com/aws-controllers-k8s/runtime/pkg/condition"
+	ackcfg "github.com/aws-controllers-k8s/runtime/pkg/config"
+	ackmetrics "github.com/aws-controllers-k8s/runtime/pkg/metrics"
+	ackrequeue "github.com/aws-controllers-k8s/runtime/pkg/requeue"
+	acktypes "github.com/aws-controllers-k8s/runtime/pkg/types"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	svcapitypes "github.com/aws-controllers-k8s/apigateway-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/apigateway-controller/pkg/dryrun"
+	svcresource "github.com/aws-controllers-k8s/apigateway-controller/pkg/resource"
+
+	_ "github.com/aws-controllers-k8s/apigateway-controller/pkg/resource/api_key"
+	_ "github.com/aws-controllers-k8s/apigateway-controller/pkg/resource/resource"
+	_ "github.com/aws-controllers-k8s/apigateway-controller/pkg/resource/rest_api"
+)
+
+// transport answers every request with an empty API Gateway response and
+// records the requests sent.
+type transport struct {
+	sent []string
+}
+
+func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.sent = append(t.sent, req.Method+" "+req.URL.Path)
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       io.NopCloser(strings.NewReader("{}")),
+		Request:    req,
+	}, nil
+}
+
+// secrets answers the reads of the values of Secrets.
+type secrets struct {
+	acktypes.Reconciler
+}
+
+func (secrets) SecretValueFromReference(context.Context, *ackv1alpha1.SecretKeyReference) (string, error) {
+	return "secret-value-0123456789", nil
+}
+
+var accounts int
+
+func restAPIManager(t *testing.T, enabled bool) (acktypes.AWSResourceManager, acktypes.AWSResourceDescriptor, *transport) {
+	t.Helper()
+	return manager(t, "RestAPI", enabled, nil)
+}
+
+func manager(t *testing.T, kind string, enabled bool, rr acktypes.Reconciler) (acktypes.AWSResourceManager, acktypes.AWSResourceDescriptor, *transport) {
+	t.Helper()
+	var factory acktypes.AWSResourceManagerFactory
+	for _, f := range dryrun.WrapManagerFactories(svcresource.GetManagerFactories(), enabled) {
+		if f.ResourceDescriptor().GroupVersionKind().Kind == kind {
+			factory = f
+		}
+	}
+	require.NotNil(t, factory)
+
+	tr := &transport{}
+	clientcfg := aws.Config{
+		Region:      "us-west-2",
+		Credentials: credentials.NewStaticCredentialsProvider("AKID", "SECRET", ""),
+		HTTPClient:  &http.Client{Transport: tr},
+	}
+	// The resource managers are cached by account, so that every manager
+	// gets its own client.
+	accounts++
+	rm, err := factory.ManagerFor(ackcfg.Config{}, clientcfg, logr.Discard(), ackmetrics.NewMetrics("apigateway"),
+		rr, ackv1alpha1.AWSAccountID(fmt.Sprintf("%012d", accounts)), "us-west-2", "")
+	require.NoError(t, err)
+	return rm, factory.ResourceDescriptor(), tr
+}
+
+func restAPI(description string, annotations map[string]string) *svcapitypes.RestAPI {
+	return &svcapitypes.RestAPI{
+		ObjectMeta: metav1.ObjectMeta{Name: "api", Namespace: "default", Annotations: annotations},
+		Spec: svcapitypes.RestAPISpec{
+			Name:        aws.String("api"),
+			Description: aws.String(description),
+		},
+		Status: svcapitypes.RestAPIStatus{ID: aws.String("api1")},
+	}
+}
+
+func TestUpdate_DryRun(t *testing.T) {
+	rm, rd, tr := restAPIManager(t, true)
+	desired := rd.ResourceFromRuntimeObject(restAPI("new", nil))
+	latest := rd.ResourceFromRuntimeObject(restAPI("old", nil))
+
+	updated, err := rm.Update(context.Background(), desired, latest, rd.Delta(desired, latest))
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, dryrun.ErrDryRun))
+	var requeue *ackrequeue.RequeueNeededAfter
+	require.True(t, errors.As(err, &requeue))
+	assert.Equal(t, dryrun.RequeueAfter, requeue.Duration())
+	assert.Empty(t, tr.sent)
+
+	synced := ackcondition.Synced(updated)
+	require.NotNil(t, synced)
+	assert.Equal(t, corev1.ConditionFalse, synced.Status)
+	assert.Equal(t, dryrun.ConditionReasonDryRun, *synced.Reason)
+	assert.Contains(t, *synced.Message, `"operation":"UpdateRestApi"`)
+	assert.Contains(t, *synced.Message, `{"Op":"replace","Path":"/description","Value":"new"}`)
+	assert.Equal(t, "old", *updated.RuntimeObject().(*svcapitypes.RestAPI).Spec.Description)
+}
+
+func TestUpdate_AnnotationOverridesController(t *testing.T) {
+	rm, rd, tr := restAPIManager(t, true)
+	disabled := map[string]string{dryrun.AnnotationDryRun: "false"}
+	desired := rd.ResourceFromRuntimeObject(restAPI("new", disabled))
+	latest := rd.ResourceFromRuntimeObject(restAPI("old", disabled))
+
+	_, err := rm.Update(context.Background(), desired, latest, rd.Delta(desired, latest))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"PATCH /restapis/api1"}, tr.sent)
+
+	rm, rd, tr = restAPIManager(t, false)
+	enabled := map[string]string{dryrun.AnnotationDryRun: "true"}
+	res := rd.ResourceFromRuntimeObject(restAPI("old", enabled))
+	_, err = rm.Delete(context.Background(), res)
+	assert.True(t, errors.Is(err, dryrun.ErrDryRun))
+	assert.Empty(t, tr.sent)
+}
+
+func TestCreate_PendingIDs(t *testing.T) {
+	rm, rd, tr := manager(t, "Resource", true, nil)
+	res := rd.ResourceFromRuntimeObject(&svcapitypes.Resource{
+		ObjectMeta: metav1.ObjectMeta{Name: "res", Namespace: "default"},
+		Spec: svcapitypes.ResourceSpec{
+			RestAPIID: aws.String("api1"),
+			ParentID:  aws.String("root"),
+			PathPart:  aws.String("pets"),
+			CORS: &svcapitypes.CORSConfiguration{
+				AllowOrigins: aws.StringSlice([]string{"https://example.com"}),
+			},
+		},
+	})
+
+	created, err := rm.Create(context.Background(), res)
+	assert.True(t, errors.Is(err, dryrun.ErrDryRun))
+	assert.Empty(t, tr.sent)
+	assert.Nil(t, created.RuntimeObject().(*svcapitypes.Resource).Status.ID)
+
+	message := *ackcondition.Synced(created).Message
+	assert.Contains(t, message, `"operation":"CreateResource"`)
+	assert.Contains(t, message, `"operation":"PutMethod"`)
+	assert.Contains(t, message, `"ResourceId":"`+dryrun.PendingID+`"`)
+}
+
+func TestCreate_RedactsSecrets(t *testing.T) {
+	rm, rd, tr := manager(t, "APIKey", true, secrets{})
+	res := rd.ResourceFromRuntimeObject(&svcapitypes.APIKey{
+		ObjectMeta: metav1.ObjectMeta{Name: "key", Namespace: "default"},
+		Spec: svcapitypes.APIKeySpec{
+			Name: aws.String("key"),
+			Value: &ackv1alpha1.SecretKeyReference{
+				SecretReference: corev1.SecretReference{Name: "key", Namespace: "default"},
+				Key:             "value",
+			},
+		},
+	})
+
+	created, err := rm.Create(context.Background(), res)
+	assert.True(t, errors.Is(err, dryrun.ErrDryRun))
+	assert.Empty(t, tr.sent)
+
+	message := *ackcondition.Synced(created).Message
+	assert.Contains(t, message, `"operation":"CreateApiKey"`)
+	assert.Contains(t, message, `"Value":"REDACTED"`)
+	assert.NotContains(t, message, "secret-value")
+}
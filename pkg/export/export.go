@@ -0,0 +1,637 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package export
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	svcsdk "github.com/aws/aws-sdk-go-v2/service/apigateway"
+	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/apigateway/types"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	svcapitypes "github.com/aws-controllers-k8s/apigateway-controller/apis/v1alpha1"
+)
+
+// AdoptionPolicyAdopt is the value of the adoption policy annotation set on
+// every exported resource. The controller then reads the existing API Gateway
+// resource identified by the adoption fields annotation instead of creating
+// a new one.
+const AdoptionPolicyAdopt = "adopt"
+
+// APIGatewayAPI is the subset of the API Gateway client used to read a REST
+// API and the resources around it.
+type APIGatewayAPI interface {
+	svcsdk.GetResourcesAPIClient
+	svcsdk.GetDeploymentsAPIClient
+	svcsdk.GetApiKeysAPIClient
+	GetRestApi(context.Context, *svcsdk.GetRestApiInput, ...func(*svcsdk.Options)) (*svcsdk.GetRestApiOutput, error)
+	GetAuthorizers(context.Context, *svcsdk.GetAuthorizersInput, ...func(*svcsdk.Options)) (*svcsdk.GetAuthorizersOutput, error)
+	GetStages(context.Context, *svcsdk.GetStagesInput, ...func(*svcsdk.Options)) (*svcsdk.GetStagesOutput, error)
+}
+
+// Manifests is the set of resources of this controller describing an
+// existing REST API.
+type Manifests struct {
+	RestAPI              *svcapitypes.RestAPI
+	Models               []*svcapitypes.Model
+	RequestValidators    []*svcapitypes.RequestValidator
+	Authorizers          []*svcapitypes.Authorizer
+	Resources            []*svcapitypes.Resource
+	Methods              []*svcapitypes.Method
+	MethodResponses      []*svcapitypes.APIMethodResponse
+	Integrations         []*svcapitypes.Integration
+	IntegrationResponses []*svcapitypes.APIIntegrationResponse
+	Deployments          []*svcapitypes.Deployment
+	Stages               []*svcapitypes.Stage
+	APIKeys              []*svcapitypes.APIKey
+}
+
+// Objects returns the exported resources in the order they should be
+// applied.
+func (m *Manifests) Objects() []client.Object {
+	objs := []client.Object{m.RestAPI}
+	for _, o := range m.Models {
+		objs = append(objs, o)
+	}
+	for _, o := range m.RequestValidators {
+		objs = append(objs, o)
+	}
+	for _, o := range m.Authorizers {
+		objs = append(objs, o)
+	}
+	for _, o := range m.Resources {
+		objs = append(objs, o)
+	}
+	for _, o := range m.Methods {
+		objs = append(objs, o)
+	}
+	for _, o := range m.MethodResponses {
+		objs = append(objs, o)
+	}
+	for _, o := range m.Integrations {
+		objs = append(objs, o)
+	}
+	for _, o := range m.IntegrationResponses {
+		objs = append(objs, o)
+	}
+	for _, o := range m.Deployments {
+		objs = append(objs, o)
+	}
+	for _, o := range m.Stages {
+		objs = append(objs, o)
+	}
+	for _, o := range m.APIKeys {
+		objs = append(objs, o)
+	}
+	return objs
+}
+
+// exporter accumulates the resources exported for a REST API.
+type exporter struct {
+	api       APIGatewayAPI
+	restAPIID string
+	namespace string
+	out       *Manifests
+	names     namer
+
+	// resourceNames maps the ID of each API Gateway resource to the name of
+	// the Resource exported for it.
+	resourceNames map[string]string
+	rootID        string
+}
+
+// Export reads a REST API with its resources, methods, integrations,
+// responses, authorizers, stages, the deployments of these stages and the API
+// keys enabled on them, and returns them as resources of this controller.
+//
+// Resources of the REST API reference each other through *Ref fields rather
+// than IDs, except where a field has no reference counterpart (e.g. the
+// deployment of a Stage) and for the root resource, which API Gateway creates
+// together with the REST API. Every resource carries the ACK adoption
+// annotations, so that applying the manifests adopts the existing API
+// Gateway resources instead of creating new ones.
+func Export(
+	ctx context.Context,
+	api APIGatewayAPI,
+	restAPIID string,
+	namespace string,
+) (*Manifests, error) {
+	e := &exporter{
+		api:           api,
+		restAPIID:     restAPIID,
+		namespace:     namespace,
+		out:           &Manifests{},
+		names:         namer{},
+		resourceNames: map[string]string{},
+	}
+	if err := e.exportRestAPI(ctx); err != nil {
+		return nil, err
+	}
+	if err := e.exportAuthorizers(ctx); err != nil {
+		return nil, err
+	}
+	if err := e.exportResources(ctx); err != nil {
+		return nil, err
+	}
+	stages, err := e.exportStages(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := e.exportDeployments(ctx, stages); err != nil {
+		return nil, err
+	}
+	if err := e.exportAPIKeys(ctx, stages); err != nil {
+		return nil, err
+	}
+	return e.out, nil
+}
+
+func (e *exporter) exportRestAPI(ctx context.Context) error {
+	resp, err := e.api.GetRestApi(ctx, &svcsdk.GetRestApiInput{RestApiId: &e.restAPIID})
+	if err != nil {
+		return fmt.Errorf("getting REST API %s: %w", e.restAPIID, err)
+	}
+	e.rootID = aws.ToString(resp.RootResourceId)
+
+	spec := svcapitypes.RestAPISpec{
+		BinaryMediaTypes:          aws.StringSlice(resp.BinaryMediaTypes),
+		Description:               resp.Description,
+		DisableExecuteAPIEndpoint: aws.Bool(resp.DisableExecuteApiEndpoint),
+		MinimumCompressionSize:    int64Ptr(resp.MinimumCompressionSize),
+		Name:                      resp.Name,
+		Policy:                    resp.Policy,
+		Tags:                      aws.StringMap(resp.Tags),
+		Version:                   resp.Version,
+	}
+	if resp.ApiKeySource != "" {
+		spec.APIKeySource = aws.String(string(resp.ApiKeySource))
+	}
+	if resp.EndpointConfiguration != nil {
+		spec.EndpointConfiguration = &svcapitypes.EndpointConfiguration{
+			VPCEndpointIDs: aws.StringSlice(resp.EndpointConfiguration.VpcEndpointIds),
+		}
+		for _, t := range resp.EndpointConfiguration.Types {
+			spec.EndpointConfiguration.Types = append(spec.EndpointConfiguration.Types, aws.String(string(t)))
+		}
+	}
+	e.out.RestAPI = &svcapitypes.RestAPI{
+		TypeMeta:   typeMeta("RestAPI"),
+		ObjectMeta: e.objectMeta(e.names.unique("RestAPI", aws.ToString(resp.Name), e.restAPIID), map[string]string{"id": e.restAPIID}),
+		Spec:       spec,
+	}
+	return nil
+}
+
+func (e *exporter) exportAuthorizers(ctx context.Context) error {
+	input := &svcsdk.GetAuthorizersInput{RestApiId: &e.restAPIID}
+	for {
+		resp, err := e.api.GetAuthorizers(ctx, input)
+		if err != nil {
+			return fmt.Errorf("getting authorizers of REST API %s: %w", e.restAPIID, err)
+		}
+		for _, a := range resp.Items {
+			spec := svcapitypes.AuthorizerSpec{
+				AuthType:                     a.AuthType,
+				AuthorizerCredentials:        a.AuthorizerCredentials,
+				AuthorizerResultTTLInSeconds: int64Ptr(a.AuthorizerResultTtlInSeconds),
+				AuthorizerURI:                a.AuthorizerUri,
+				IdentitySource:               a.IdentitySource,
+				IdentityValidationExpression: a.IdentityValidationExpression,
+				Name:                         a.Name,
+				ProviderARNs:                 aws.StringSlice(a.ProviderARNs),
+				RestAPIRef:                   e.restAPIRef(),
+			}
+			if a.Type != "" {
+				spec.Type = aws.String(string(a.Type))
+			}
+			e.out.Authorizers = append(e.out.Authorizers, &svcapitypes.Authorizer{
+				TypeMeta: typeMeta("Authorizer"),
+				ObjectMeta: e.objectMeta(
+					e.names.unique("Authorizer", e.out.RestAPI.Name+"-"+aws.ToString(a.Name), aws.ToString(a.Id)),
+					map[string]string{"id": aws.ToString(a.Id), "restAPIID": e.restAPIID},
+				),
+				Spec: spec,
+			})
+		}
+		if resp.Position == nil {
+			return nil
+		}
+		input.Position = resp.Position
+	}
+}
+
+func (e *exporter) exportResources(ctx context.Context) error {
+	var resources []svcsdktypes.Resource
+	paginator := svcsdk.NewGetResourcesPaginator(e.api, &svcsdk.GetResourcesInput{
+		RestApiId: &e.restAPIID,
+		Embed:     []string{"methods"},
+	})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return fmt.Errorf("getting resources of REST API %s: %w", e.restAPIID, err)
+		}
+		resources = append(resources, page.Items...)
+	}
+	// Parents sort before their children, so that the name of the parent of
+	// each resource is known by the time the resource is exported.
+	sort.Slice(resources, func(i, j int) bool {
+		return aws.ToString(resources[i].Path) < aws.ToString(resources[j].Path)
+	})
+
+	for _, r := range resources {
+		resourceID := aws.ToString(r.Id)
+		name := e.out.RestAPI.Name
+		if resourceID != e.rootID {
+			name = e.names.unique("Resource", e.out.RestAPI.Name+aws.ToString(r.Path), resourceID)
+			spec := svcapitypes.ResourceSpec{
+				PathPart:   r.PathPart,
+				RestAPIRef: e.restAPIRef(),
+			}
+			if parent, ok := e.resourceNames[aws.ToString(r.ParentId)]; ok {
+				spec.ParentRef = localRef(parent)
+			} else {
+				spec.ParentID = r.ParentId
+			}
+			e.resourceNames[resourceID] = name
+			e.out.Resources = append(e.out.Resources, &svcapitypes.Resource{
+				TypeMeta:   typeMeta("Resource"),
+				ObjectMeta: e.objectMeta(name, map[string]string{"id": resourceID, "restAPIID": e.restAPIID}),
+				Spec:       spec,
+			})
+		}
+
+		httpMethods := make([]string, 0, len(r.ResourceMethods))
+		for httpMethod := range r.ResourceMethods {
+			httpMethods = append(httpMethods, httpMethod)
+		}
+		sort.Strings(httpMethods)
+		for _, httpMethod := range httpMethods {
+			e.exportMethod(name, resourceID, httpMethod, r.ResourceMethods[httpMethod])
+		}
+	}
+	return nil
+}
+
+// exportMethod exports a method with its integration and responses. Methods
+// of the root resource are named after the RestAPI.
+func (e *exporter) exportMethod(
+	resourceName string,
+	resourceID string,
+	httpMethod string,
+	m svcsdktypes.Method,
+) {
+	name := e.names.unique("Method", resourceName+"-"+httpMethod, resourceID+httpMethod)
+	methodFields := map[string]string{
+		"resourceID": resourceID,
+		"httpMethod": httpMethod,
+		"restAPIID":  e.restAPIID,
+	}
+	idField, resourceRef := e.resourceIDOrRef(resourceID)
+
+	e.out.Methods = append(e.out.Methods, &svcapitypes.Method{
+		TypeMeta:   typeMeta("Method"),
+		ObjectMeta: e.objectMeta(name, methodFields),
+		Spec: svcapitypes.MethodSpec{
+			APIKeyRequired:      m.ApiKeyRequired,
+			AuthorizationScopes: aws.StringSlice(m.AuthorizationScopes),
+			AuthorizationType:   m.AuthorizationType,
+			AuthorizerID:        m.AuthorizerId,
+			HTTPMethod:          aws.String(httpMethod),
+			OperationName:       m.OperationName,
+			RequestModels:       aws.StringMap(m.RequestModels),
+			RequestParameters:   aws.BoolMap(m.RequestParameters),
+			RequestValidatorID:  m.RequestValidatorId,
+			ResourceID:          idField,
+			ResourceRef:         resourceRef,
+			RestAPIRef:          e.restAPIRef(),
+		},
+	})
+
+	for _, statusCode := range sortedKeys(m.MethodResponses) {
+		mr := m.MethodResponses[statusCode]
+		e.out.MethodResponses = append(e.out.MethodResponses, &svcapitypes.APIMethodResponse{
+			TypeMeta:   typeMeta("APIMethodResponse"),
+			ObjectMeta: e.objectMeta(e.names.unique("APIMethodResponse", name+"-"+statusCode, name+statusCode), withStatusCode(methodFields, statusCode)),
+			Spec: svcapitypes.APIMethodResponseSpec{
+				HTTPMethod:         aws.String(httpMethod),
+				ResourceID:         idField,
+				ResourceRef:        resourceRef,
+				ResponseModels:     aws.StringMap(mr.ResponseModels),
+				ResponseParameters: aws.BoolMap(mr.ResponseParameters),
+				RestAPIRef:         e.restAPIRef(),
+				StatusCode:         aws.String(statusCode),
+			},
+		})
+	}
+
+	i := m.MethodIntegration
+	if i == nil {
+		return
+	}
+	spec := svcapitypes.IntegrationSpec{
+		CacheKeyParameters:    aws.StringSlice(i.CacheKeyParameters),
+		CacheNamespace:        i.CacheNamespace,
+		ConnectionID:          i.ConnectionId,
+		Credentials:           i.Credentials,
+		HTTPMethod:            aws.String(httpMethod),
+		IntegrationHTTPMethod: i.HttpMethod,
+		PassthroughBehavior:   i.PassthroughBehavior,
+		RequestParameters:     aws.StringMap(i.RequestParameters),
+		RequestTemplates:      aws.StringMap(i.RequestTemplates),
+		ResourceID:            idField,
+		ResourceRef:           resourceRef,
+		RestAPIRef:            e.restAPIRef(),
+		TimeoutInMillis:       aws.Int64(int64(i.TimeoutInMillis)),
+		URI:                   i.Uri,
+	}
+	if i.ConnectionType != "" {
+		spec.ConnectionType = aws.String(string(i.ConnectionType))
+	}
+	if i.ContentHandling != "" {
+		spec.ContentHandling = aws.String(string(i.ContentHandling))
+	}
+	if i.TlsConfig != nil {
+		spec.TLSConfig = &svcapitypes.TLSConfig{
+			InsecureSkipVerification: aws.Bool(i.TlsConfig.InsecureSkipVerification),
+		}
+	}
+	if i.Type != "" {
+		spec.Type = aws.String(string(i.Type))
+	}
+	e.out.Integrations = append(e.out.Integrations, &svcapitypes.Integration{
+		TypeMeta:   typeMeta("Integration"),
+		ObjectMeta: e.objectMeta(name, methodFields),
+		Spec:       spec,
+	})
+
+	for _, statusCode := range sortedKeys(i.IntegrationResponses) {
+		ir := i.IntegrationResponses[statusCode]
+		irSpec := svcapitypes.APIIntegrationResponseSpec{
+			HTTPMethod:         aws.String(httpMethod),
+			ResourceID:         idField,
+			ResourceRef:        resourceRef,
+			ResponseParameters: aws.StringMap(ir.ResponseParameters),
+			ResponseTemplates:  aws.StringMap(ir.ResponseTemplates),
+			RestAPIRef:         e.restAPIRef(),
+			SelectionPattern:   ir.SelectionPattern,
+			StatusCode:         aws.String(statusCode),
+		}
+		if ir.ContentHandling != "" {
+			irSpec.ContentHandling = aws.String(string(ir.ContentHandling))
+		}
+		e.out.IntegrationResponses = append(e.out.IntegrationResponses, &svcapitypes.APIIntegrationResponse{
+			TypeMeta:   typeMeta("APIIntegrationResponse"),
+			ObjectMeta: e.objectMeta(e.names.unique("APIIntegrationResponse", name+"-"+statusCode, name+statusCode), withStatusCode(methodFields, statusCode)),
+			Spec:       irSpec,
+		})
+	}
+}
+
+// exportStages exports the stages of the REST API and returns their names by
+// deployment ID.
+func (e *exporter) exportStages(ctx context.Context) (map[string][]string, error) {
+	resp, err := e.api.GetStages(ctx, &svcsdk.GetStagesInput{RestApiId: &e.restAPIID})
+	if err != nil {
+		return nil, fmt.Errorf("getting stages of REST API %s: %w", e.restAPIID, err)
+	}
+	stages := map[string][]string{}
+	items := resp.Item
+	sort.Slice(items, func(i, j int) bool {
+		return aws.ToString(items[i].StageName) < aws.ToString(items[j].StageName)
+	})
+	for _, s := range items {
+		stageName := aws.ToString(s.StageName)
+		stages[aws.ToString(s.DeploymentId)] = append(stages[aws.ToString(s.DeploymentId)], stageName)
+		spec := svcapitypes.StageSpec{
+			CacheClusterEnabled:  aws.Bool(s.CacheClusterEnabled),
+			DeploymentID:         s.DeploymentId,
+			Description:          s.Description,
+			DocumentationVersion: s.DocumentationVersion,
+			RestAPIRef:           e.restAPIRef(),
+			StageName:            s.StageName,
+			Tags:                 aws.StringMap(s.Tags),
+			TracingEnabled:       aws.Bool(s.TracingEnabled),
+			Variables:            aws.StringMap(s.Variables),
+		}
+		if s.CacheClusterSize != "" {
+			spec.CacheClusterSize = aws.String(string(s.CacheClusterSize))
+		}
+		if c := s.CanarySettings; c != nil {
+			spec.CanarySettings = &svcapitypes.CanarySettings{
+				DeploymentID:           c.DeploymentId,
+				PercentTraffic:         aws.Float64(c.PercentTraffic),
+				StageVariableOverrides: aws.StringMap(c.StageVariableOverrides),
+				UseStageCache:          aws.Bool(c.UseStageCache),
+			}
+			if c.DeploymentId != nil {
+				stages[*c.DeploymentId] = append(stages[*c.DeploymentId], stageName)
+			}
+		}
+		e.out.Stages = append(e.out.Stages, &svcapitypes.Stage{
+			TypeMeta: typeMeta("Stage"),
+			ObjectMeta: e.objectMeta(
+				e.names.unique("Stage", e.out.RestAPI.Name+"-"+stageName, stageName),
+				map[string]string{"stageName": stageName, "restAPIID": e.restAPIID},
+			),
+			Spec: spec,
+		})
+	}
+	return stages, nil
+}
+
+// exportDeployments exports the deployments of the REST API that a stage
+// points to. Older deployments are only kept by API Gateway for rollbacks
+// and are left out.
+func (e *exporter) exportDeployments(ctx context.Context, stages map[string][]string) error {
+	paginator := svcsdk.NewGetDeploymentsPaginator(e.api, &svcsdk.GetDeploymentsInput{RestApiId: &e.restAPIID})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return fmt.Errorf("getting deployments of REST API %s: %w", e.restAPIID, err)
+		}
+		for _, d := range page.Items {
+			deploymentID := aws.ToString(d.Id)
+			if _, ok := stages[deploymentID]; !ok {
+				continue
+			}
+			e.out.Deployments = append(e.out.Deployments, &svcapitypes.Deployment{
+				TypeMeta: typeMeta("Deployment"),
+				ObjectMeta: e.objectMeta(
+					e.names.unique("Deployment", e.out.RestAPI.Name+"-"+deploymentID, deploymentID),
+					map[string]string{"id": deploymentID, "restAPIID": e.restAPIID},
+				),
+				Spec: svcapitypes.DeploymentSpec{
+					Description: d.Description,
+					RestAPIRef:  e.restAPIRef(),
+				},
+			})
+		}
+	}
+	return nil
+}
+
+// exportAPIKeys exports the API keys enabled on at least one stage of the
+// REST API. The values of the keys are not exported.
+func (e *exporter) exportAPIKeys(ctx context.Context, stages map[string][]string) error {
+	stageNames := map[string]bool{}
+	for _, names := range stages {
+		for _, name := range names {
+			stageNames[name] = true
+		}
+	}
+	paginator := svcsdk.NewGetApiKeysPaginator(e.api, &svcsdk.GetApiKeysInput{})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return fmt.Errorf("getting API keys: %w", err)
+		}
+		for _, k := range page.Items {
+			var stageKeys []*svcapitypes.StageKey
+			for _, sk := range k.StageKeys {
+				restAPIID, stageName, _ := strings.Cut(sk, "/")
+				if restAPIID == e.restAPIID && stageNames[stageName] {
+					stageKeys = append(stageKeys, &svcapitypes.StageKey{
+						RestAPIID: aws.String(restAPIID),
+						StageName: aws.String(stageName),
+					})
+				}
+			}
+			if len(stageKeys) == 0 {
+				continue
+			}
+			e.out.APIKeys = append(e.out.APIKeys, &svcapitypes.APIKey{
+				TypeMeta:   typeMeta("APIKey"),
+				ObjectMeta: e.objectMeta(e.names.unique("APIKey", aws.ToString(k.Name), aws.ToString(k.Id)), map[string]string{"id": aws.ToString(k.Id)}),
+				Spec: svcapitypes.APIKeySpec{
+					CustomerID:  k.CustomerId,
+					Description: k.Description,
+					Enabled:     aws.Bool(k.Enabled),
+					Name:        k.Name,
+					StageKeys:   stageKeys,
+					Tags:        aws.StringMap(k.Tags),
+				},
+			})
+		}
+	}
+	return nil
+}
+
+// resourceIDOrRef returns a reference to the Resource exported for an API
+// Gateway resource, or the ID of the resource if it is the root resource.
+func (e *exporter) resourceIDOrRef(resourceID string) (*string, *ackv1alpha1.AWSResourceReferenceWrapper) {
+	if name, ok := e.resourceNames[resourceID]; ok {
+		return nil, localRef(name)
+	}
+	return aws.String(resourceID), nil
+}
+
+func (e *exporter) restAPIRef() *ackv1alpha1.AWSResourceReferenceWrapper {
+	return localRef(e.out.RestAPI.Name)
+}
+
+// objectMeta returns the metadata of an exported resource, with the adoption
+// annotations identifying the existing API Gateway resource.
+func (e *exporter) objectMeta(name string, adoptionFields map[string]string) metav1.ObjectMeta {
+	// Marshaling a map of strings cannot fail.
+	fields, _ := json.Marshal(adoptionFields)
+	return metav1.ObjectMeta{
+		Name:      name,
+		Namespace: e.namespace,
+		Annotations: map[string]string{
+			ackv1alpha1.AnnotationAdoptionPolicy: AdoptionPolicyAdopt,
+			ackv1alpha1.AnnotationAdoptionFields: string(fields),
+		},
+	}
+}
+
+// namer holds the Kubernetes names given so far to each kind, so that two
+// API Gateway resources never end up with the same name.
+type namer map[string]map[string]bool
+
+// unique turns a name into a valid Kubernetes name that was not given to
+// another resource of the same kind yet. Clashing names are made unique with
+// a hash of the supplied key.
+func (n namer) unique(kind, name, key string) string {
+	if n[kind] == nil {
+		n[kind] = map[string]bool{}
+	}
+	name = sanitizeName(name)
+	if name == "" || n[kind][name] {
+		sum := sha256.Sum256([]byte(key))
+		name = strings.TrimSuffix(name+"-"+hex.EncodeToString(sum[:])[:8], "-")
+		name = strings.TrimPrefix(name, "-")
+	}
+	n[kind][name] = true
+	return name
+}
+
+var invalidNameChars = regexp.MustCompile(`[^a-z0-9]+`)
+
+// sanitizeName maps a name onto a valid Kubernetes object name, e.g.
+// "orders-/orders/{id}" onto "orders-orders-id".
+func sanitizeName(name string) string {
+	name = invalidNameChars.ReplaceAllString(strings.ToLower(name), "-")
+	if len(name) > 200 {
+		name = name[:200]
+	}
+	return strings.Trim(name, "-")
+}
+
+func typeMeta(kind string) metav1.TypeMeta {
+	return metav1.TypeMeta{
+		APIVersion: svcapitypes.GroupVersion.String(),
+		Kind:       kind,
+	}
+}
+
+func localRef(name string) *ackv1alpha1.AWSResourceReferenceWrapper {
+	return &ackv1alpha1.AWSResourceReferenceWrapper{
+		From: &ackv1alpha1.AWSResourceReference{Name: aws.String(name)},
+	}
+}
+
+func withStatusCode(fields map[string]string, statusCode string) map[string]string {
+	out := map[string]string{"statusCode": statusCode}
+	for k, v := range fields {
+		out[k] = v
+	}
+	return out
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func int64Ptr(v *int32) *int64 {
+	if v == nil {
+		return nil
+	}
+	return aws.Int64(int64(*v))
+}
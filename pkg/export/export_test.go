@@ -0,0 +1,148 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package export_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	svcsdk "github.com/aws/aws-sdk-go-v2/service/apigateway"
+	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/apigateway/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aws-controllers-k8s/apigateway-controller/pkg/export"
+)
+
+type fakeAPI struct{}
+
+func (fakeAPI) GetRestApi(context.Context, *svcsdk.GetRestApiInput, ...func(*svcsdk.Options)) (*svcsdk.GetRestApiOutput, error) {
+	return &svcsdk.GetRestApiOutput{
+		Id:             aws.String("api1"),
+		Name:           aws.String("Orders API"),
+		RootResourceId: aws.String("root"),
+		EndpointConfiguration: &svcsdktypes.EndpointConfiguration{
+			Types: []svcsdktypes.EndpointType{svcsdktypes.EndpointTypeRegional},
+		},
+	}, nil
+}
+
+func (fakeAPI) GetAuthorizers(context.Context, *svcsdk.GetAuthorizersInput, ...func(*svcsdk.Options)) (*svcsdk.GetAuthorizersOutput, error) {
+	return &svcsdk.GetAuthorizersOutput{Items: []svcsdktypes.Authorizer{{
+		Id:   aws.String("auth1"),
+		Name: aws.String("cognito"),
+		Type: svcsdktypes.AuthorizerTypeCognitoUserPools,
+	}}}, nil
+}
+
+func (fakeAPI) GetResources(context.Context, *svcsdk.GetResourcesInput, ...func(*svcsdk.Options)) (*svcsdk.GetResourcesOutput, error) {
+	get := svcsdktypes.Method{
+		AuthorizationType: aws.String("NONE"),
+		MethodResponses: map[string]svcsdktypes.MethodResponse{
+			"200": {StatusCode: aws.String("200")},
+		},
+		MethodIntegration: &svcsdktypes.Integration{
+			Type:            svcsdktypes.IntegrationTypeHttpProxy,
+			HttpMethod:      aws.String("GET"),
+			Uri:             aws.String("https://example.com/orders/{id}"),
+			TimeoutInMillis: 29000,
+			IntegrationResponses: map[string]svcsdktypes.IntegrationResponse{
+				"200": {StatusCode: aws.String("200")},
+			},
+		},
+	}
+	return &svcsdk.GetResourcesOutput{Items: []svcsdktypes.Resource{
+		{Id: aws.String("res2"), ParentId: aws.String("res1"), Path: aws.String("/orders/{id}"), PathPart: aws.String("{id}"),
+			ResourceMethods: map[string]svcsdktypes.Method{"GET": get}},
+		{Id: aws.String("root"), Path: aws.String("/"),
+			ResourceMethods: map[string]svcsdktypes.Method{"ANY": {AuthorizationType: aws.String("NONE")}}},
+		{Id: aws.String("res1"), ParentId: aws.String("root"), Path: aws.String("/orders"), PathPart: aws.String("orders")},
+	}}, nil
+}
+
+func (fakeAPI) GetStages(context.Context, *svcsdk.GetStagesInput, ...func(*svcsdk.Options)) (*svcsdk.GetStagesOutput, error) {
+	return &svcsdk.GetStagesOutput{Item: []svcsdktypes.Stage{{
+		StageName:    aws.String("prod"),
+		DeploymentId: aws.String("dep2"),
+	}}}, nil
+}
+
+func (fakeAPI) GetDeployments(context.Context, *svcsdk.GetDeploymentsInput, ...func(*svcsdk.Options)) (*svcsdk.GetDeploymentsOutput, error) {
+	return &svcsdk.GetDeploymentsOutput{Items: []svcsdktypes.Deployment{
+		{Id: aws.String("dep1")},
+		{Id: aws.String("dep2"), Description: aws.String("current")},
+	}}, nil
+}
+
+func (fakeAPI) GetApiKeys(context.Context, *svcsdk.GetApiKeysInput, ...func(*svcsdk.Options)) (*svcsdk.GetApiKeysOutput, error) {
+	return &svcsdk.GetApiKeysOutput{Items: []svcsdktypes.ApiKey{
+		{Id: aws.String("key1"), Name: aws.String("partner"), Enabled: true, StageKeys: []string{"api1/prod", "other/prod"}},
+		{Id: aws.String("key2"), Name: aws.String("unrelated"), StageKeys: []string{"other/prod"}},
+	}}, nil
+}
+
+func TestExport(t *testing.T) {
+	m, err := export.Export(context.Background(), fakeAPI{}, "api1", "apis")
+	require.NoError(t, err)
+
+	assert.Equal(t, "orders-api", m.RestAPI.Name)
+	assert.Equal(t, "apis", m.RestAPI.Namespace)
+	assert.Equal(t, export.AdoptionPolicyAdopt, m.RestAPI.Annotations[ackv1alpha1.AnnotationAdoptionPolicy])
+	assert.JSONEq(t, `{"id": "api1"}`, m.RestAPI.Annotations[ackv1alpha1.AnnotationAdoptionFields])
+
+	require.Len(t, m.Authorizers, 1)
+	assert.Equal(t, "orders-api-cognito", m.Authorizers[0].Name)
+	assert.Equal(t, "orders-api", *m.Authorizers[0].Spec.RestAPIRef.From.Name)
+
+	require.Len(t, m.Resources, 2)
+	assert.Equal(t, "orders-api-orders", m.Resources[0].Name)
+	assert.Equal(t, "root", *m.Resources[0].Spec.ParentID)
+	assert.Equal(t, "orders-api-orders-id", m.Resources[1].Name)
+	assert.Equal(t, "orders-api-orders", *m.Resources[1].Spec.ParentRef.From.Name)
+
+	require.Len(t, m.Methods, 2)
+	assert.Equal(t, "orders-api-any", m.Methods[0].Name)
+	assert.Equal(t, "root", *m.Methods[0].Spec.ResourceID)
+	assert.Equal(t, "orders-api-orders-id-get", m.Methods[1].Name)
+	assert.Equal(t, "orders-api-orders-id", *m.Methods[1].Spec.ResourceRef.From.Name)
+	assert.Nil(t, m.Methods[1].Spec.ResourceID)
+	assert.JSONEq(t, `{"resourceID": "res2", "httpMethod": "GET", "restAPIID": "api1"}`,
+		m.Methods[1].Annotations[ackv1alpha1.AnnotationAdoptionFields])
+
+	require.Len(t, m.Integrations, 1)
+	assert.Equal(t, "HTTP_PROXY", *m.Integrations[0].Spec.Type)
+	require.Len(t, m.MethodResponses, 1)
+	require.Len(t, m.IntegrationResponses, 1)
+	assert.Equal(t, "orders-api-orders-id-get-200", m.IntegrationResponses[0].Name)
+	assert.JSONEq(t, `{"resourceID": "res2", "httpMethod": "GET", "restAPIID": "api1", "statusCode": "200"}`,
+		m.IntegrationResponses[0].Annotations[ackv1alpha1.AnnotationAdoptionFields])
+
+	require.Len(t, m.Stages, 1)
+	assert.Equal(t, "dep2", *m.Stages[0].Spec.DeploymentID)
+	require.Len(t, m.Deployments, 1)
+	assert.Equal(t, "current", *m.Deployments[0].Spec.Description)
+
+	require.Len(t, m.APIKeys, 1)
+	require.Len(t, m.APIKeys[0].Spec.StageKeys, 1)
+	assert.Equal(t, "prod", *m.APIKeys[0].Spec.StageKeys[0].StageName)
+
+	var buf bytes.Buffer
+	require.NoError(t, export.WriteYAML(&buf, m.Objects()))
+	assert.Contains(t, buf.String(), "kind: RestAPI\n")
+	assert.NotContains(t, buf.String(), "status:")
+	assert.NotContains(t, buf.String(), "creationTimestamp")
+}
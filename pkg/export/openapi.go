@@ -0,0 +1,731 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package export
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+
+	svcapitypes "github.com/aws-controllers-k8s/apigateway-controller/apis/v1alpha1"
+)
+
+// ConvertOptions configures the conversion of an OpenAPI document.
+type ConvertOptions struct {
+	// Namespace of the generated resources.
+	Namespace string
+	// RootResourceID is the ID of the root resource of the REST API. API
+	// Gateway creates the root resource together with the REST API, so the
+	// resources directly under "/" and the methods of "/" refer to it by ID.
+	RootResourceID string
+}
+
+// openAPIMethods maps the operation keys of a path item onto HTTP methods.
+var openAPIMethods = map[string]string{
+	"get":                            "GET",
+	"put":                            "PUT",
+	"post":                           "POST",
+	"delete":                         "DELETE",
+	"options":                        "OPTIONS",
+	"head":                           "HEAD",
+	"patch":                          "PATCH",
+	"x-amazon-apigateway-any-method": "ANY",
+}
+
+// openAPIParameterLocations maps the location of a parameter onto the
+// location used in the request parameters of a method.
+var openAPIParameterLocations = map[string]string{
+	"path":   "path",
+	"query":  "querystring",
+	"header": "header",
+}
+
+var statusCodePattern = regexp.MustCompile(`^[1-5][0-9][0-9]$`)
+
+// openAPIDocument holds the parts of a Swagger 2 or OpenAPI 3 document, with
+// the API Gateway extensions, that have an equivalent in this controller.
+type openAPIDocument struct {
+	Swagger string `json:"swagger"`
+	OpenAPI string `json:"openapi"`
+	Info    struct {
+		Title       string `json:"title"`
+		Description string `json:"description"`
+		Version     string `json:"version"`
+	} `json:"info"`
+	Paths               map[string]map[string]json.RawMessage `json:"paths"`
+	Consumes            []string                              `json:"consumes"`
+	Produces            []string                              `json:"produces"`
+	Security            []map[string][]string                 `json:"security"`
+	Definitions         map[string]json.RawMessage            `json:"definitions"`
+	Parameters          map[string]openAPIParameter           `json:"parameters"`
+	SecurityDefinitions map[string]openAPISecurityScheme      `json:"securityDefinitions"`
+	Components          struct {
+		Schemas         map[string]json.RawMessage       `json:"schemas"`
+		Parameters      map[string]openAPIParameter      `json:"parameters"`
+		SecuritySchemes map[string]openAPISecurityScheme `json:"securitySchemes"`
+	} `json:"components"`
+
+	APIKeySource           string                             `json:"x-amazon-apigateway-api-key-source"`
+	BinaryMediaTypes       []string                           `json:"x-amazon-apigateway-binary-media-types"`
+	MinimumCompressionSize *int64                             `json:"x-amazon-apigateway-minimum-compression-size"`
+	Policy                 json.RawMessage                    `json:"x-amazon-apigateway-policy"`
+	RequestValidator       string                             `json:"x-amazon-apigateway-request-validator"`
+	RequestValidators      map[string]openAPIRequestValidator `json:"x-amazon-apigateway-request-validators"`
+}
+
+type openAPIOperation struct {
+	Parameters  []openAPIParameter         `json:"parameters"`
+	Consumes    []string                   `json:"consumes"`
+	Produces    []string                   `json:"produces"`
+	RequestBody *openAPIRequestBody        `json:"requestBody"`
+	Responses   map[string]openAPIResponse `json:"responses"`
+	Security    *[]map[string][]string     `json:"security"`
+
+	Integration      *openAPIIntegration `json:"x-amazon-apigateway-integration"`
+	RequestValidator string              `json:"x-amazon-apigateway-request-validator"`
+}
+
+type openAPIParameter struct {
+	Ref      string            `json:"$ref"`
+	Name     string            `json:"name"`
+	In       string            `json:"in"`
+	Required bool              `json:"required"`
+	Schema   *openAPISchemaRef `json:"schema"`
+}
+
+type openAPISchemaRef struct {
+	Ref string `json:"$ref"`
+}
+
+type openAPIMediaType struct {
+	Schema *openAPISchemaRef `json:"schema"`
+}
+
+type openAPIRequestBody struct {
+	Content map[string]openAPIMediaType `json:"content"`
+}
+
+type openAPIResponse struct {
+	Headers map[string]json.RawMessage  `json:"headers"`
+	Schema  *openAPISchemaRef           `json:"schema"`
+	Content map[string]openAPIMediaType `json:"content"`
+}
+
+type openAPIIntegration struct {
+	Type                string                                `json:"type"`
+	HTTPMethod          string                                `json:"httpMethod"`
+	URI                 string                                `json:"uri"`
+	Credentials         string                                `json:"credentials"`
+	ConnectionType      string                                `json:"connectionType"`
+	ConnectionID        string                                `json:"connectionId"`
+	PassthroughBehavior string                                `json:"passthroughBehavior"`
+	ContentHandling     string                                `json:"contentHandling"`
+	TimeoutInMillis     *int64                                `json:"timeoutInMillis"`
+	CacheNamespace      string                                `json:"cacheNamespace"`
+	CacheKeyParameters  []string                              `json:"cacheKeyParameters"`
+	RequestParameters   map[string]string                     `json:"requestParameters"`
+	RequestTemplates    map[string]string                     `json:"requestTemplates"`
+	Responses           map[string]openAPIIntegrationResponse `json:"responses"`
+	TLSConfig           *struct {
+		InsecureSkipVerification bool `json:"insecureSkipVerification"`
+	} `json:"tlsConfig"`
+}
+
+type openAPIIntegrationResponse struct {
+	// StatusCode is a json.Number, so that both quoted and unquoted status
+	// codes are accepted.
+	StatusCode         json.Number       `json:"statusCode"`
+	ResponseParameters map[string]string `json:"responseParameters"`
+	ResponseTemplates  map[string]string `json:"responseTemplates"`
+	ContentHandling    string            `json:"contentHandling"`
+}
+
+type openAPISecurityScheme struct {
+	Type       string             `json:"type"`
+	Name       string             `json:"name"`
+	In         string             `json:"in"`
+	AuthType   string             `json:"x-amazon-apigateway-authtype"`
+	Authorizer *openAPIAuthorizer `json:"x-amazon-apigateway-authorizer"`
+}
+
+type openAPIAuthorizer struct {
+	Type                         string   `json:"type"`
+	AuthorizerURI                string   `json:"authorizerUri"`
+	AuthorizerCredentials        string   `json:"authorizerCredentials"`
+	AuthorizerResultTTLInSeconds *int64   `json:"authorizerResultTtlInSeconds"`
+	IdentitySource               string   `json:"identitySource"`
+	IdentityValidationExpression string   `json:"identityValidationExpression"`
+	ProviderARNs                 []string `json:"providerARNs"`
+}
+
+type openAPIRequestValidator struct {
+	ValidateRequestBody       bool `json:"validateRequestBody"`
+	ValidateRequestParameters bool `json:"validateRequestParameters"`
+}
+
+// converter accumulates the resources generated for an OpenAPI document.
+type converter struct {
+	doc   *openAPIDocument
+	opts  ConvertOptions
+	out   *Manifests
+	names namer
+
+	// resourceNames, modelNames, validatorNames and authorizerNames map
+	// respectively paths, schemas, request validators and security schemes of
+	// the document to the name of the resource generated for them.
+	resourceNames   map[string]string
+	modelNames      map[string]string
+	validatorNames  map[string]string
+	authorizerNames map[string]string
+}
+
+// ConvertOpenAPI converts a Swagger 2 or OpenAPI 3 document, in JSON or
+// YAML, into the resources of this controller describing the same REST API.
+// The conversion runs offline and understands the API Gateway extensions for
+// integrations, authorizers and request validators.
+//
+// Resources reference each other through *Ref fields, except for the root
+// resource of the REST API, which is referenced by ID. Schemas become Models
+// named after the schema; references between schemas are kept as they are,
+// so a schema referring to another one has to use the model URL expected by
+// API Gateway. Inline schemas are not converted.
+func ConvertOpenAPI(data []byte, opts ConvertOptions) (*Manifests, error) {
+	raw, err := yaml.YAMLToJSON(data)
+	if err != nil {
+		return nil, fmt.Errorf("parsing OpenAPI document: %w", err)
+	}
+	doc := &openAPIDocument{}
+	if err := json.Unmarshal(raw, doc); err != nil {
+		return nil, fmt.Errorf("parsing OpenAPI document: %w", err)
+	}
+	if doc.Swagger == "" && doc.OpenAPI == "" {
+		return nil, errors.New("not a Swagger 2 or OpenAPI 3 document")
+	}
+	if doc.Info.Title == "" {
+		return nil, errors.New("OpenAPI document has no title")
+	}
+	if opts.RootResourceID == "" {
+		return nil, errors.New("the ID of the root resource of the REST API is required")
+	}
+
+	c := &converter{
+		doc:             doc,
+		opts:            opts,
+		out:             &Manifests{},
+		names:           namer{},
+		resourceNames:   map[string]string{},
+		modelNames:      map[string]string{},
+		validatorNames:  map[string]string{},
+		authorizerNames: map[string]string{},
+	}
+	c.convertRestAPI()
+	if err := c.convertModels(); err != nil {
+		return nil, err
+	}
+	c.convertRequestValidators()
+	c.convertAuthorizers()
+	if err := c.convertPaths(); err != nil {
+		return nil, err
+	}
+	return c.out, nil
+}
+
+func (c *converter) convertRestAPI() {
+	spec := svcapitypes.RestAPISpec{
+		BinaryMediaTypes:       aws.StringSlice(c.doc.BinaryMediaTypes),
+		Description:            stringOrNil(c.doc.Info.Description),
+		MinimumCompressionSize: c.doc.MinimumCompressionSize,
+		Name:                   aws.String(c.doc.Info.Title),
+		Version:                stringOrNil(c.doc.Info.Version),
+	}
+	if c.doc.APIKeySource != "" {
+		spec.APIKeySource = aws.String(strings.ToUpper(c.doc.APIKeySource))
+	}
+	if len(c.doc.Policy) > 0 && string(c.doc.Policy) != "null" {
+		// The policy is either a JSON document or a string holding one.
+		var policy string
+		if err := json.Unmarshal(c.doc.Policy, &policy); err != nil {
+			policy = string(c.doc.Policy)
+		}
+		spec.Policy = aws.String(policy)
+	}
+	c.out.RestAPI = &svcapitypes.RestAPI{
+		TypeMeta:   typeMeta("RestAPI"),
+		ObjectMeta: c.objectMeta(c.names.unique("RestAPI", c.doc.Info.Title, c.doc.Info.Title)),
+		Spec:       spec,
+	}
+}
+
+func (c *converter) convertModels() error {
+	schemas := c.doc.Definitions
+	if len(schemas) == 0 {
+		schemas = c.doc.Components.Schemas
+	}
+	for _, schemaName := range sortedKeys(schemas) {
+		var schema bytes.Buffer
+		if err := json.Compact(&schema, schemas[schemaName]); err != nil {
+			return fmt.Errorf("reading schema %s: %w", schemaName, err)
+		}
+		name := c.names.unique("Model", c.out.RestAPI.Name+"-"+schemaName, schemaName)
+		c.modelNames[schemaName] = name
+		c.out.Models = append(c.out.Models, &svcapitypes.Model{
+			TypeMeta:   typeMeta("Model"),
+			ObjectMeta: c.objectMeta(name),
+			Spec: svcapitypes.ModelSpec{
+				ContentType: aws.String("application/json"),
+				Name:        aws.String(schemaName),
+				RestAPIRef:  c.restAPIRef(),
+				Schema:      aws.String(schema.String()),
+			},
+		})
+	}
+	return nil
+}
+
+func (c *converter) convertRequestValidators() {
+	for _, validatorName := range sortedKeys(c.doc.RequestValidators) {
+		v := c.doc.RequestValidators[validatorName]
+		name := c.names.unique("RequestValidator", c.out.RestAPI.Name+"-"+validatorName, validatorName)
+		c.validatorNames[validatorName] = name
+		c.out.RequestValidators = append(c.out.RequestValidators, &svcapitypes.RequestValidator{
+			TypeMeta:   typeMeta("RequestValidator"),
+			ObjectMeta: c.objectMeta(name),
+			Spec: svcapitypes.RequestValidatorSpec{
+				Name:                      aws.String(validatorName),
+				RestAPIRef:                c.restAPIRef(),
+				ValidateRequestBody:       aws.Bool(v.ValidateRequestBody),
+				ValidateRequestParameters: aws.Bool(v.ValidateRequestParameters),
+			},
+		})
+	}
+}
+
+func (c *converter) convertAuthorizers() {
+	schemes := c.securitySchemes()
+	for _, schemeName := range sortedKeys(schemes) {
+		scheme := schemes[schemeName]
+		a := scheme.Authorizer
+		if a == nil {
+			continue
+		}
+		spec := svcapitypes.AuthorizerSpec{
+			AuthType:                     stringOrNil(scheme.AuthType),
+			AuthorizerCredentials:        stringOrNil(a.AuthorizerCredentials),
+			AuthorizerResultTTLInSeconds: a.AuthorizerResultTTLInSeconds,
+			AuthorizerURI:                stringOrNil(a.AuthorizerURI),
+			IdentitySource:               stringOrNil(a.IdentitySource),
+			IdentityValidationExpression: stringOrNil(a.IdentityValidationExpression),
+			Name:                         aws.String(schemeName),
+			ProviderARNs:                 aws.StringSlice(a.ProviderARNs),
+			RestAPIRef:                   c.restAPIRef(),
+			Type:                         aws.String(strings.ToUpper(a.Type)),
+		}
+		// Token authorizers read the token from the header named by the
+		// security scheme unless told otherwise.
+		if spec.IdentitySource == nil && scheme.In == "header" && scheme.Name != "" {
+			spec.IdentitySource = aws.String("method.request.header." + scheme.Name)
+		}
+		name := c.names.unique("Authorizer", c.out.RestAPI.Name+"-"+schemeName, schemeName)
+		c.authorizerNames[schemeName] = name
+		c.out.Authorizers = append(c.out.Authorizers, &svcapitypes.Authorizer{
+			TypeMeta:   typeMeta("Authorizer"),
+			ObjectMeta: c.objectMeta(name),
+			Spec:       spec,
+		})
+	}
+}
+
+// convertPaths generates a Resource for every path of the document and for
+// every missing parent of these paths, and converts the operations of each
+// path.
+func (c *converter) convertPaths() error {
+	paths := map[string]bool{}
+	for path := range c.doc.Paths {
+		var prefix string
+		for _, part := range strings.Split(strings.Trim(path, "/"), "/") {
+			if part == "" {
+				continue
+			}
+			prefix += "/" + part
+			paths[prefix] = true
+		}
+	}
+	// Parents sort before their children, so that the name of the parent of
+	// each resource is known by the time the resource is generated.
+	for _, path := range sortedKeys(paths) {
+		i := strings.LastIndex(path, "/")
+		parent, pathPart := path[:i], path[i+1:]
+		spec := svcapitypes.ResourceSpec{
+			PathPart:   aws.String(pathPart),
+			RestAPIRef: c.restAPIRef(),
+		}
+		if parent == "" {
+			spec.ParentID = aws.String(c.opts.RootResourceID)
+		} else {
+			spec.ParentRef = localRef(c.resourceNames[parent])
+		}
+		name := c.names.unique("Resource", c.out.RestAPI.Name+path, path)
+		c.resourceNames[path] = name
+		c.out.Resources = append(c.out.Resources, &svcapitypes.Resource{
+			TypeMeta:   typeMeta("Resource"),
+			ObjectMeta: c.objectMeta(name),
+			Spec:       spec,
+		})
+	}
+
+	for _, path := range sortedKeys(c.doc.Paths) {
+		item := c.doc.Paths[path]
+		var parameters []openAPIParameter
+		if raw, ok := item["parameters"]; ok {
+			if err := json.Unmarshal(raw, &parameters); err != nil {
+				return fmt.Errorf("reading parameters of path %s: %w", path, err)
+			}
+		}
+		for _, key := range sortedKeys(item) {
+			httpMethod, ok := openAPIMethods[key]
+			if !ok {
+				continue
+			}
+			op := &openAPIOperation{}
+			if err := json.Unmarshal(item[key], op); err != nil {
+				return fmt.Errorf("reading operation %s %s: %w", key, path, err)
+			}
+			if err := c.convertMethod(path, httpMethod, parameters, op); err != nil {
+				return fmt.Errorf("converting operation %s %s: %w", key, path, err)
+			}
+		}
+	}
+	return nil
+}
+
+// convertMethod converts an operation into a method with its integration and
+// responses. Methods of the root resource are named after the RestAPI.
+func (c *converter) convertMethod(
+	path string,
+	httpMethod string,
+	pathParameters []openAPIParameter,
+	op *openAPIOperation,
+) error {
+	path = "/" + strings.Trim(path, "/")
+	resourceName := c.out.RestAPI.Name
+	idField := aws.String(c.opts.RootResourceID)
+	var resourceRef *ackv1alpha1.AWSResourceReferenceWrapper
+	if path != "/" {
+		resourceName = c.resourceNames[path]
+		idField, resourceRef = nil, localRef(resourceName)
+	}
+	name := c.names.unique("Method", resourceName+"-"+httpMethod, path+httpMethod)
+
+	spec := svcapitypes.MethodSpec{
+		AuthorizationType: aws.String("NONE"),
+		HTTPMethod:        aws.String(httpMethod),
+		ResourceID:        idField,
+		ResourceRef:       resourceRef,
+		RestAPIRef:        c.restAPIRef(),
+	}
+	if err := c.setRequest(&spec, pathParameters, op); err != nil {
+		return err
+	}
+	if err := c.setAuthorization(&spec, op); err != nil {
+		return err
+	}
+	validator := op.RequestValidator
+	if validator == "" {
+		validator = c.doc.RequestValidator
+	}
+	if validator != "" {
+		validatorName, ok := c.validatorNames[validator]
+		if !ok {
+			return fmt.Errorf("unknown request validator %q", validator)
+		}
+		spec.RequestValidatorRef = localRef(validatorName)
+	}
+	c.out.Methods = append(c.out.Methods, &svcapitypes.Method{
+		TypeMeta:   typeMeta("Method"),
+		ObjectMeta: c.objectMeta(name),
+		Spec:       spec,
+	})
+
+	for _, statusCode := range sortedKeys(op.Responses) {
+		// API Gateway has no counterpart for the "default" response.
+		if !statusCodePattern.MatchString(statusCode) {
+			continue
+		}
+		r := op.Responses[statusCode]
+		responseModels := map[string]*string{}
+		if r.Schema != nil {
+			if model := c.modelName(r.Schema.Ref); model != "" {
+				for _, contentType := range c.mediaTypes(op.Produces, c.doc.Produces) {
+					responseModels[contentType] = aws.String(model)
+				}
+			}
+		}
+		for contentType, media := range r.Content {
+			if media.Schema != nil {
+				if model := c.modelName(media.Schema.Ref); model != "" {
+					responseModels[contentType] = aws.String(model)
+				}
+			}
+		}
+		responseParameters := map[string]*bool{}
+		for header := range r.Headers {
+			responseParameters["method.response.header."+header] = aws.Bool(false)
+		}
+		c.out.MethodResponses = append(c.out.MethodResponses, &svcapitypes.APIMethodResponse{
+			TypeMeta:   typeMeta("APIMethodResponse"),
+			ObjectMeta: c.objectMeta(c.names.unique("APIMethodResponse", name+"-"+statusCode, name+statusCode)),
+			Spec: svcapitypes.APIMethodResponseSpec{
+				HTTPMethod:         aws.String(httpMethod),
+				ResourceID:         idField,
+				ResourceRef:        resourceRef,
+				ResponseModels:     nilIfEmpty(responseModels),
+				ResponseParameters: nilIfEmpty(responseParameters),
+				RestAPIRef:         c.restAPIRef(),
+				StatusCode:         aws.String(statusCode),
+			},
+		})
+	}
+
+	i := op.Integration
+	if i == nil {
+		return nil
+	}
+	integration := svcapitypes.IntegrationSpec{
+		CacheKeyParameters:    aws.StringSlice(i.CacheKeyParameters),
+		CacheNamespace:        stringOrNil(i.CacheNamespace),
+		ConnectionID:          stringOrNil(i.ConnectionID),
+		ConnectionType:        upperOrNil(i.ConnectionType),
+		ContentHandling:       upperOrNil(i.ContentHandling),
+		Credentials:           stringOrNil(i.Credentials),
+		HTTPMethod:            aws.String(httpMethod),
+		IntegrationHTTPMethod: upperOrNil(i.HTTPMethod),
+		PassthroughBehavior:   upperOrNil(i.PassthroughBehavior),
+		RequestParameters:     aws.StringMap(i.RequestParameters),
+		RequestTemplates:      aws.StringMap(i.RequestTemplates),
+		ResourceID:            idField,
+		ResourceRef:           resourceRef,
+		RestAPIRef:            c.restAPIRef(),
+		TimeoutInMillis:       i.TimeoutInMillis,
+		Type:                  upperOrNil(i.Type),
+		URI:                   stringOrNil(i.URI),
+	}
+	if i.TLSConfig != nil {
+		integration.TLSConfig = &svcapitypes.TLSConfig{
+			InsecureSkipVerification: aws.Bool(i.TLSConfig.InsecureSkipVerification),
+		}
+	}
+	c.out.Integrations = append(c.out.Integrations, &svcapitypes.Integration{
+		TypeMeta:   typeMeta("Integration"),
+		ObjectMeta: c.objectMeta(name),
+		Spec:       integration,
+	})
+
+	// The responses of an integration are keyed by selection pattern, while
+	// API Gateway identifies them by status code. The first pattern of each
+	// status code wins.
+	statusCodes := map[string]bool{}
+	for _, pattern := range sortedKeys(i.Responses) {
+		r := i.Responses[pattern]
+		statusCode := r.StatusCode.String()
+		if statusCode == "" {
+			return fmt.Errorf("integration response %q has no status code", pattern)
+		}
+		if statusCodes[statusCode] {
+			continue
+		}
+		statusCodes[statusCode] = true
+		spec := svcapitypes.APIIntegrationResponseSpec{
+			ContentHandling:    upperOrNil(r.ContentHandling),
+			HTTPMethod:         aws.String(httpMethod),
+			ResourceID:         idField,
+			ResourceRef:        resourceRef,
+			ResponseParameters: aws.StringMap(r.ResponseParameters),
+			ResponseTemplates:  aws.StringMap(r.ResponseTemplates),
+			RestAPIRef:         c.restAPIRef(),
+			StatusCode:         aws.String(statusCode),
+		}
+		if pattern != "default" {
+			spec.SelectionPattern = aws.String(pattern)
+		}
+		c.out.IntegrationResponses = append(c.out.IntegrationResponses, &svcapitypes.APIIntegrationResponse{
+			TypeMeta:   typeMeta("APIIntegrationResponse"),
+			ObjectMeta: c.objectMeta(c.names.unique("APIIntegrationResponse", name+"-"+statusCode, name+statusCode)),
+			Spec:       spec,
+		})
+	}
+	return nil
+}
+
+// setRequest sets the request parameters and models of a method from the
+// parameters of its path and operation and from the request body.
+func (c *converter) setRequest(
+	spec *svcapitypes.MethodSpec,
+	pathParameters []openAPIParameter,
+	op *openAPIOperation,
+) error {
+	requestParameters := map[string]*bool{}
+	requestModels := map[string]*string{}
+	for _, p := range append(append([]openAPIParameter{}, pathParameters...), op.Parameters...) {
+		p, err := c.resolveParameter(p)
+		if err != nil {
+			return err
+		}
+		if location, ok := openAPIParameterLocations[p.In]; ok {
+			requestParameters["method.request."+location+"."+p.Name] = aws.Bool(p.Required)
+		}
+		if p.In == "body" && p.Schema != nil {
+			if model := c.modelName(p.Schema.Ref); model != "" {
+				for _, contentType := range c.mediaTypes(op.Consumes, c.doc.Consumes) {
+					requestModels[contentType] = aws.String(model)
+				}
+			}
+		}
+	}
+	if op.RequestBody != nil {
+		for contentType, media := range op.RequestBody.Content {
+			if media.Schema != nil {
+				if model := c.modelName(media.Schema.Ref); model != "" {
+					requestModels[contentType] = aws.String(model)
+				}
+			}
+		}
+	}
+	spec.RequestParameters = nilIfEmpty(requestParameters)
+	spec.RequestModels = nilIfEmpty(requestModels)
+	return nil
+}
+
+// setAuthorization sets the authorization of a method from the security
+// requirements of its operation, or of the document if the operation has
+// none.
+func (c *converter) setAuthorization(spec *svcapitypes.MethodSpec, op *openAPIOperation) error {
+	requirements := c.doc.Security
+	if op.Security != nil {
+		requirements = *op.Security
+	}
+	schemes := c.securitySchemes()
+	for _, requirement := range requirements {
+		for _, schemeName := range sortedKeys(requirement) {
+			scheme, ok := schemes[schemeName]
+			if !ok {
+				return fmt.Errorf("unknown security scheme %q", schemeName)
+			}
+			switch {
+			case scheme.Authorizer != nil:
+				spec.AuthorizerRef = localRef(c.authorizerNames[schemeName])
+				if strings.EqualFold(scheme.Authorizer.Type, "cognito_user_pools") {
+					spec.AuthorizationType = aws.String("COGNITO_USER_POOLS")
+					spec.AuthorizationScopes = aws.StringSlice(requirement[schemeName])
+				} else {
+					spec.AuthorizationType = aws.String("CUSTOM")
+				}
+			case strings.EqualFold(scheme.AuthType, "awsSigv4"):
+				spec.AuthorizationType = aws.String("AWS_IAM")
+			case scheme.Type == "apiKey":
+				spec.APIKeyRequired = aws.Bool(true)
+			}
+		}
+	}
+	return nil
+}
+
+// resolveParameter returns the parameter a parameter reference points to.
+func (c *converter) resolveParameter(p openAPIParameter) (openAPIParameter, error) {
+	if p.Ref == "" {
+		return p, nil
+	}
+	if name, ok := strings.CutPrefix(p.Ref, "#/parameters/"); ok {
+		if resolved, ok := c.doc.Parameters[name]; ok {
+			return resolved, nil
+		}
+	}
+	if name, ok := strings.CutPrefix(p.Ref, "#/components/parameters/"); ok {
+		if resolved, ok := c.doc.Components.Parameters[name]; ok {
+			return resolved, nil
+		}
+	}
+	return p, fmt.Errorf("unknown parameter %q", p.Ref)
+}
+
+// modelName returns the name of the API Gateway model generated for the
+// schema a reference points to, or an empty string if it points to no known
+// schema.
+func (c *converter) modelName(ref string) string {
+	for _, prefix := range []string{"#/definitions/", "#/components/schemas/"} {
+		if name, ok := strings.CutPrefix(ref, prefix); ok {
+			if _, ok := c.modelNames[name]; ok {
+				return name
+			}
+		}
+	}
+	return ""
+}
+
+// mediaTypes returns the media types of a Swagger 2 operation, which default
+// to the ones of the document and then to JSON.
+func (c *converter) mediaTypes(operation, document []string) []string {
+	if len(operation) > 0 {
+		return operation
+	}
+	if len(document) > 0 {
+		return document
+	}
+	return []string{"application/json"}
+}
+
+// securitySchemes returns the security schemes of a Swagger 2 or OpenAPI 3
+// document.
+func (c *converter) securitySchemes() map[string]openAPISecurityScheme {
+	if len(c.doc.SecurityDefinitions) > 0 {
+		return c.doc.SecurityDefinitions
+	}
+	return c.doc.Components.SecuritySchemes
+}
+
+func (c *converter) restAPIRef() *ackv1alpha1.AWSResourceReferenceWrapper {
+	return localRef(c.out.RestAPI.Name)
+}
+
+func (c *converter) objectMeta(name string) metav1.ObjectMeta {
+	return metav1.ObjectMeta{
+		Name:      name,
+		Namespace: c.opts.Namespace,
+	}
+}
+
+func stringOrNil(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return aws.String(s)
+}
+
+func upperOrNil(s string) *string {
+	return stringOrNil(strings.ToUpper(s))
+}
+
+func nilIfEmpty[V any](m map[string]V) map[string]V {
+	if len(m) == 0 {
+		return nil
+	}
+	return m
+}
@@ -0,0 +1,643 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package export
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	svcapitypes "github.com/aws-controllers-k8s/apigateway-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/apigateway-controller/pkg/util"
+)
+
+// builtinModels are the models API Gateway creates with every REST API.
+// They are added to the OpenAPI document when a method refers to them.
+var builtinModels = map[string]string{
+	"Empty": `{"$schema": "http://json-schema.org/draft-04/schema#", "title": "Empty Schema", "type": "object"}`,
+	"Error": `{"$schema": "http://json-schema.org/draft-04/schema#", "title": "Error Schema", "type": "object", "properties": {"message": {"type": "string"}}}`,
+}
+
+const (
+	apiKeySecurityScheme = "api_key"
+	sigV4SecurityScheme  = "sigv4"
+)
+
+// ListObjects lists the resources of this controller an OpenAPI document
+// can be built from in a namespace.
+func ListObjects(ctx context.Context, c client.Reader, namespace string) ([]client.Object, error) {
+	var objs []client.Object
+	lists := []client.ObjectList{
+		&svcapitypes.RestAPIList{},
+		&svcapitypes.ModelList{},
+		&svcapitypes.RequestValidatorList{},
+		&svcapitypes.AuthorizerList{},
+		&svcapitypes.ResourceList{},
+		&svcapitypes.MethodList{},
+		&svcapitypes.APIMethodResponseList{},
+		&svcapitypes.IntegrationList{},
+		&svcapitypes.APIIntegrationResponseList{},
+	}
+	for _, list := range lists {
+		if err := c.List(ctx, list, client.InNamespace(namespace)); err != nil {
+			return nil, err
+		}
+		items, err := meta.ExtractList(list)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range items {
+			objs = append(objs, item.(client.Object))
+		}
+	}
+	return objs, nil
+}
+
+// SelectRestAPI returns the RestAPI with the given name among objs, together
+// with the resources referring to it. The name may be left empty when objs
+// hold a single RestAPI.
+func SelectRestAPI(objs []client.Object, name string) (*Manifests, error) {
+	m := &Manifests{}
+	for _, obj := range objs {
+		if restAPI, ok := obj.(*svcapitypes.RestAPI); ok && (name == "" || restAPI.Name == name) {
+			if m.RestAPI != nil {
+				return nil, errors.New("found several RestAPIs, the name of the RestAPI is required")
+			}
+			m.RestAPI = restAPI
+		}
+	}
+	if m.RestAPI == nil {
+		return nil, fmt.Errorf("RestAPI %q not found", name)
+	}
+
+	belongs := func(obj client.Object, ref *ackv1alpha1.AWSResourceReferenceWrapper, id *string) bool {
+		if obj.GetNamespace() != m.RestAPI.Namespace {
+			return false
+		}
+		if ref != nil && ref.From != nil {
+			return aws.ToString(ref.From.Name) == m.RestAPI.Name &&
+				(ref.From.Namespace == nil || *ref.From.Namespace == m.RestAPI.Namespace)
+		}
+		return id != nil && m.RestAPI.Status.ID != nil && *id == *m.RestAPI.Status.ID
+	}
+	for _, obj := range objs {
+		switch o := obj.(type) {
+		case *svcapitypes.Model:
+			if belongs(o, o.Spec.RestAPIRef, o.Spec.RestAPIID) {
+				m.Models = append(m.Models, o)
+			}
+		case *svcapitypes.RequestValidator:
+			if belongs(o, o.Spec.RestAPIRef, o.Spec.RestAPIID) {
+				m.RequestValidators = append(m.RequestValidators, o)
+			}
+		case *svcapitypes.Authorizer:
+			if belongs(o, o.Spec.RestAPIRef, o.Spec.RestAPIID) {
+				m.Authorizers = append(m.Authorizers, o)
+			}
+		case *svcapitypes.Resource:
+			if belongs(o, o.Spec.RestAPIRef, o.Spec.RestAPIID) {
+				m.Resources = append(m.Resources, o)
+			}
+		case *svcapitypes.Method:
+			if belongs(o, o.Spec.RestAPIRef, o.Spec.RestAPIID) {
+				m.Methods = append(m.Methods, o)
+			}
+		case *svcapitypes.APIMethodResponse:
+			if belongs(o, o.Spec.RestAPIRef, o.Spec.RestAPIID) {
+				m.MethodResponses = append(m.MethodResponses, o)
+			}
+		case *svcapitypes.Integration:
+			if belongs(o, o.Spec.RestAPIRef, o.Spec.RestAPIID) {
+				m.Integrations = append(m.Integrations, o)
+			}
+		case *svcapitypes.APIIntegrationResponse:
+			if belongs(o, o.Spec.RestAPIRef, o.Spec.RestAPIID) {
+				m.IntegrationResponses = append(m.IntegrationResponses, o)
+			}
+		}
+	}
+	return m, nil
+}
+
+// builder accumulates the OpenAPI document built from the resources of a
+// REST API.
+type builder struct {
+	m *Manifests
+
+	// resourcesByName and resourcesByID index the Resources by name and by
+	// the ID of the API Gateway resource, and paths holds the path computed
+	// for each of them.
+	resourcesByName map[string]*svcapitypes.Resource
+	resourcesByID   map[string]*svcapitypes.Resource
+	paths           map[*svcapitypes.Resource]string
+
+	// operations holds the operations of the document by path and HTTP
+	// method.
+	operations map[string]map[string]map[string]interface{}
+	schemes    map[string]interface{}
+	schemas    map[string]interface{}
+}
+
+// BuildOpenAPI builds an OpenAPI 3 document, with the API Gateway extensions,
+// describing a REST API from its resources. AWS is not called: references
+// between resources are followed through the *Ref fields and through the IDs
+// reported in the status of the resources.
+//
+// Resources and methods whose resource or parent ID matches no Resource are
+// taken to belong to the root resource, as the ID of the root resource is
+// only known once the REST API exists.
+func BuildOpenAPI(m *Manifests) (map[string]interface{}, error) {
+	b := &builder{
+		m:               m,
+		resourcesByName: map[string]*svcapitypes.Resource{},
+		resourcesByID:   map[string]*svcapitypes.Resource{},
+		paths:           map[*svcapitypes.Resource]string{},
+		operations:      map[string]map[string]map[string]interface{}{},
+		schemes:         map[string]interface{}{},
+		schemas:         map[string]interface{}{},
+	}
+	for _, r := range m.Resources {
+		b.resourcesByName[r.Name] = r
+		if r.Status.ID != nil {
+			b.resourcesByID[*r.Status.ID] = r
+		}
+	}
+	for _, model := range m.Models {
+		var schema interface{} = map[string]interface{}{}
+		if model.Spec.Schema == nil {
+			b.schemas[aws.ToString(model.Spec.Name)] = schema
+			continue
+		}
+		if err := json.Unmarshal([]byte(aws.ToString(model.Spec.Schema)), &schema); err != nil {
+			return nil, fmt.Errorf("reading schema of Model %s: %w", model.Name, err)
+		}
+		b.schemas[aws.ToString(model.Spec.Name)] = schema
+	}
+	for _, a := range m.Authorizers {
+		b.schemes[aws.ToString(a.Spec.Name)] = authorizerScheme(a)
+	}
+
+	for _, method := range m.Methods {
+		if err := b.addMethod(method); err != nil {
+			return nil, fmt.Errorf("converting Method %s: %w", method.Name, err)
+		}
+	}
+	for _, r := range m.MethodResponses {
+		op, err := b.operation(r.Spec.ResourceRef, r.Spec.ResourceID, r.Spec.HTTPMethod)
+		if err != nil {
+			return nil, fmt.Errorf("converting APIMethodResponse %s: %w", r.Name, err)
+		}
+		op["responses"].(map[string]interface{})[aws.ToString(r.Spec.StatusCode)] = b.methodResponse(r)
+	}
+	for _, i := range m.Integrations {
+		op, err := b.operation(i.Spec.ResourceRef, i.Spec.ResourceID, i.Spec.HTTPMethod)
+		if err != nil {
+			return nil, fmt.Errorf("converting Integration %s: %w", i.Name, err)
+		}
+		op["x-amazon-apigateway-integration"] = integration(i)
+	}
+	for _, r := range m.IntegrationResponses {
+		op, err := b.operation(r.Spec.ResourceRef, r.Spec.ResourceID, r.Spec.HTTPMethod)
+		if err != nil {
+			return nil, fmt.Errorf("converting APIIntegrationResponse %s: %w", r.Name, err)
+		}
+		i, ok := op["x-amazon-apigateway-integration"].(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("converting APIIntegrationResponse %s: method has no integration", r.Name)
+		}
+		pattern := aws.ToString(r.Spec.SelectionPattern)
+		if pattern == "" {
+			pattern = "default"
+		}
+		i["responses"].(map[string]interface{})[pattern] = integrationResponse(r)
+	}
+
+	paths := map[string]interface{}{}
+	for path, ops := range b.operations {
+		item := map[string]interface{}{}
+		for httpMethod, op := range ops {
+			key := strings.ToLower(httpMethod)
+			if httpMethod == "ANY" {
+				key = "x-amazon-apigateway-any-method"
+			}
+			item[key] = op
+		}
+		paths[path] = item
+	}
+	// Paths without methods are kept, so that the document describes every
+	// resource of the REST API.
+	for _, r := range m.Resources {
+		path, err := b.path(r, 0)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := paths[path]; !ok {
+			paths[path] = map[string]interface{}{}
+		}
+	}
+
+	return b.document(paths), nil
+}
+
+func (b *builder) document(paths map[string]interface{}) map[string]interface{} {
+	spec := b.m.RestAPI.Spec
+	info := map[string]interface{}{
+		"title":   aws.ToString(spec.Name),
+		"version": "1.0",
+	}
+	if spec.Version != nil {
+		info["version"] = *spec.Version
+	}
+	if spec.Description != nil {
+		info["description"] = *spec.Description
+	}
+	doc := map[string]interface{}{
+		"openapi": "3.0.1",
+		"info":    info,
+		"paths":   paths,
+	}
+	components := map[string]interface{}{}
+	if len(b.schemas) > 0 {
+		components["schemas"] = b.schemas
+	}
+	if len(b.schemes) > 0 {
+		components["securitySchemes"] = b.schemes
+	}
+	if len(components) > 0 {
+		doc["components"] = components
+	}
+
+	if spec.APIKeySource != nil {
+		doc["x-amazon-apigateway-api-key-source"] = *spec.APIKeySource
+	}
+	if len(spec.BinaryMediaTypes) > 0 {
+		doc["x-amazon-apigateway-binary-media-types"] = aws.ToStringSlice(spec.BinaryMediaTypes)
+	}
+	if spec.MinimumCompressionSize != nil {
+		doc["x-amazon-apigateway-minimum-compression-size"] = *spec.MinimumCompressionSize
+	}
+	rendered := spec.Policy
+	if spec.PolicySpec != nil {
+		// The policy spec is validated on admission, so it renders.
+		if p, err := util.RenderRestAPIPolicy(spec.PolicySpec, spec.EndpointConfiguration); err == nil {
+			rendered = &p
+		}
+	}
+	if rendered != nil {
+		var policy interface{}
+		if err := json.Unmarshal([]byte(*rendered), &policy); err != nil {
+			policy = *rendered
+		}
+		doc["x-amazon-apigateway-policy"] = policy
+	}
+	if len(b.m.RequestValidators) > 0 {
+		validators := map[string]interface{}{}
+		for _, v := range b.m.RequestValidators {
+			validators[aws.ToString(v.Spec.Name)] = map[string]interface{}{
+				"validateRequestBody":       aws.ToBool(v.Spec.ValidateRequestBody),
+				"validateRequestParameters": aws.ToBool(v.Spec.ValidateRequestParameters),
+			}
+		}
+		doc["x-amazon-apigateway-request-validators"] = validators
+	}
+	return doc
+}
+
+// addMethod adds the operation of a method, without its responses and
+// integration.
+func (b *builder) addMethod(method *svcapitypes.Method) error {
+	path, err := b.resourcePath(method.Spec.ResourceRef, method.Spec.ResourceID)
+	if err != nil {
+		return err
+	}
+	httpMethod := strings.ToUpper(aws.ToString(method.Spec.HTTPMethod))
+	if b.operations[path] == nil {
+		b.operations[path] = map[string]map[string]interface{}{}
+	}
+	if _, ok := b.operations[path][httpMethod]; ok {
+		return fmt.Errorf("duplicate method %s %s", httpMethod, path)
+	}
+	op := map[string]interface{}{
+		"responses": map[string]interface{}{},
+	}
+	if method.Spec.OperationName != nil {
+		op["operationId"] = *method.Spec.OperationName
+	}
+
+	var parameters []interface{}
+	for _, key := range sortedKeys(method.Spec.RequestParameters) {
+		parts := strings.SplitN(key, ".", 4)
+		if len(parts) != 4 || parts[0] != "method" || parts[1] != "request" {
+			continue
+		}
+		in := parts[2]
+		if in == "querystring" {
+			in = "query"
+		}
+		if in != "path" && in != "query" && in != "header" {
+			continue
+		}
+		parameters = append(parameters, map[string]interface{}{
+			"name":     parts[3],
+			"in":       in,
+			"required": in == "path" || aws.ToBool(method.Spec.RequestParameters[key]),
+			"schema":   map[string]interface{}{"type": "string"},
+		})
+	}
+	if len(parameters) > 0 {
+		op["parameters"] = parameters
+	}
+	if content := b.content(method.Spec.RequestModels); content != nil {
+		op["requestBody"] = map[string]interface{}{"content": content}
+	}
+
+	requirement := map[string]interface{}{}
+	switch aws.ToString(method.Spec.AuthorizationType) {
+	case "CUSTOM", "COGNITO_USER_POOLS":
+		name, err := b.authorizerName(method.Spec.AuthorizerRef, method.Spec.AuthorizerID)
+		if err != nil {
+			return err
+		}
+		requirement[name] = aws.ToStringSlice(method.Spec.AuthorizationScopes)
+	case "AWS_IAM":
+		requirement[sigV4SecurityScheme] = []string{}
+		b.schemes[sigV4SecurityScheme] = map[string]interface{}{
+			"type":                         "apiKey",
+			"name":                         "Authorization",
+			"in":                           "header",
+			"x-amazon-apigateway-authtype": "awsSigv4",
+		}
+	}
+	if aws.ToBool(method.Spec.APIKeyRequired) {
+		requirement[apiKeySecurityScheme] = []string{}
+		b.schemes[apiKeySecurityScheme] = map[string]interface{}{
+			"type": "apiKey",
+			"name": "x-api-key",
+			"in":   "header",
+		}
+	}
+	if len(requirement) > 0 {
+		op["security"] = []interface{}{requirement}
+	}
+
+	if method.Spec.RequestValidatorRef != nil || method.Spec.RequestValidatorID != nil {
+		name, err := b.requestValidatorName(method.Spec.RequestValidatorRef, method.Spec.RequestValidatorID)
+		if err != nil {
+			return err
+		}
+		op["x-amazon-apigateway-request-validator"] = name
+	}
+	b.operations[path][httpMethod] = op
+	return nil
+}
+
+func (b *builder) methodResponse(r *svcapitypes.APIMethodResponse) map[string]interface{} {
+	statusCode := aws.ToString(r.Spec.StatusCode)
+	response := map[string]interface{}{
+		"description": statusCode + " response",
+	}
+	headers := map[string]interface{}{}
+	for key := range r.Spec.ResponseParameters {
+		if header, ok := strings.CutPrefix(key, "method.response.header."); ok {
+			headers[header] = map[string]interface{}{
+				"schema": map[string]interface{}{"type": "string"},
+			}
+		}
+	}
+	if len(headers) > 0 {
+		response["headers"] = headers
+	}
+	if content := b.content(r.Spec.ResponseModels); content != nil {
+		response["content"] = content
+	}
+	return response
+}
+
+// content returns the content of a request or response with the given
+// models by media type, adding the built-in models it refers to.
+func (b *builder) content(models map[string]*string) map[string]interface{} {
+	if len(models) == 0 {
+		return nil
+	}
+	content := map[string]interface{}{}
+	for mediaType, model := range models {
+		name := aws.ToString(model)
+		if _, ok := b.schemas[name]; !ok {
+			if schema, ok := builtinModels[name]; ok {
+				var s interface{}
+				_ = json.Unmarshal([]byte(schema), &s)
+				b.schemas[name] = s
+			}
+		}
+		content[mediaType] = map[string]interface{}{
+			"schema": map[string]interface{}{"$ref": "#/components/schemas/" + name},
+		}
+	}
+	return content
+}
+
+func integration(i *svcapitypes.Integration) map[string]interface{} {
+	spec := i.Spec
+	out := map[string]interface{}{
+		"responses": map[string]interface{}{},
+	}
+	setString(out, "type", lowerOrNil(spec.Type))
+	setString(out, "httpMethod", spec.IntegrationHTTPMethod)
+	setString(out, "uri", spec.URI)
+	setString(out, "credentials", spec.Credentials)
+	setString(out, "connectionType", spec.ConnectionType)
+	setString(out, "connectionId", spec.ConnectionID)
+	setString(out, "passthroughBehavior", lowerOrNil(spec.PassthroughBehavior))
+	setString(out, "contentHandling", spec.ContentHandling)
+	setString(out, "cacheNamespace", spec.CacheNamespace)
+	if spec.TimeoutInMillis != nil {
+		out["timeoutInMillis"] = *spec.TimeoutInMillis
+	}
+	if len(spec.CacheKeyParameters) > 0 {
+		out["cacheKeyParameters"] = aws.ToStringSlice(spec.CacheKeyParameters)
+	}
+	if len(spec.RequestParameters) > 0 {
+		out["requestParameters"] = aws.ToStringMap(spec.RequestParameters)
+	}
+	if len(spec.RequestTemplates) > 0 {
+		out["requestTemplates"] = aws.ToStringMap(spec.RequestTemplates)
+	}
+	if spec.TLSConfig != nil {
+		out["tlsConfig"] = map[string]interface{}{
+			"insecureSkipVerification": aws.ToBool(spec.TLSConfig.InsecureSkipVerification),
+		}
+	}
+	return out
+}
+
+func integrationResponse(r *svcapitypes.APIIntegrationResponse) map[string]interface{} {
+	out := map[string]interface{}{
+		"statusCode": aws.ToString(r.Spec.StatusCode),
+	}
+	setString(out, "contentHandling", r.Spec.ContentHandling)
+	if len(r.Spec.ResponseParameters) > 0 {
+		out["responseParameters"] = aws.ToStringMap(r.Spec.ResponseParameters)
+	}
+	if len(r.Spec.ResponseTemplates) > 0 {
+		out["responseTemplates"] = aws.ToStringMap(r.Spec.ResponseTemplates)
+	}
+	return out
+}
+
+// authorizerScheme returns the security scheme of an authorizer. API
+// Gateway describes every authorizer as an API key read from the header of
+// its identity source.
+func authorizerScheme(a *svcapitypes.Authorizer) map[string]interface{} {
+	spec := a.Spec
+	authorizer := map[string]interface{}{}
+	setString(authorizer, "type", lowerOrNil(spec.Type))
+	setString(authorizer, "authorizerUri", spec.AuthorizerURI)
+	setString(authorizer, "authorizerCredentials", spec.AuthorizerCredentials)
+	setString(authorizer, "identitySource", spec.IdentitySource)
+	setString(authorizer, "identityValidationExpression", spec.IdentityValidationExpression)
+	if spec.AuthorizerResultTTLInSeconds != nil {
+		authorizer["authorizerResultTtlInSeconds"] = *spec.AuthorizerResultTTLInSeconds
+	}
+	if len(spec.ProviderARNs) > 0 {
+		authorizer["providerARNs"] = aws.ToStringSlice(spec.ProviderARNs)
+	}
+
+	header := "Unused"
+	if h, ok := strings.CutPrefix(aws.ToString(spec.IdentitySource), "method.request.header."); ok && !strings.Contains(h, ",") {
+		header = h
+	}
+	scheme := map[string]interface{}{
+		"type":                           "apiKey",
+		"name":                           header,
+		"in":                             "header",
+		"x-amazon-apigateway-authorizer": authorizer,
+	}
+	setString(scheme, "x-amazon-apigateway-authtype", spec.AuthType)
+	return scheme
+}
+
+// operation returns the operation of the method of a resource.
+func (b *builder) operation(
+	resourceRef *ackv1alpha1.AWSResourceReferenceWrapper,
+	resourceID *string,
+	httpMethod *string,
+) (map[string]interface{}, error) {
+	path, err := b.resourcePath(resourceRef, resourceID)
+	if err != nil {
+		return nil, err
+	}
+	op, ok := b.operations[path][strings.ToUpper(aws.ToString(httpMethod))]
+	if !ok {
+		return nil, fmt.Errorf("no Method %s for path %s", aws.ToString(httpMethod), path)
+	}
+	return op, nil
+}
+
+// resourcePath returns the path of the resource a reference or ID points to.
+func (b *builder) resourcePath(
+	ref *ackv1alpha1.AWSResourceReferenceWrapper,
+	id *string,
+) (string, error) {
+	if ref != nil && ref.From != nil {
+		r, ok := b.resourcesByName[aws.ToString(ref.From.Name)]
+		if !ok {
+			return "", fmt.Errorf("unknown Resource %q", aws.ToString(ref.From.Name))
+		}
+		return b.path(r, 0)
+	}
+	if r, ok := b.resourcesByID[aws.ToString(id)]; ok {
+		return b.path(r, 0)
+	}
+	return "/", nil
+}
+
+// path returns the path of a Resource. depth guards against cycles between
+// Resources.
+func (b *builder) path(r *svcapitypes.Resource, depth int) (string, error) {
+	if path, ok := b.paths[r]; ok {
+		return path, nil
+	}
+	if depth > len(b.m.Resources) {
+		return "", fmt.Errorf("cycle between the parents of Resource %s", r.Name)
+	}
+	var parent string
+	switch {
+	case r.Spec.ParentRef != nil && r.Spec.ParentRef.From != nil:
+		p, ok := b.resourcesByName[aws.ToString(r.Spec.ParentRef.From.Name)]
+		if !ok {
+			return "", fmt.Errorf("unknown parent Resource %q of Resource %s", aws.ToString(r.Spec.ParentRef.From.Name), r.Name)
+		}
+		var err error
+		if parent, err = b.path(p, depth+1); err != nil {
+			return "", err
+		}
+	default:
+		if p, ok := b.resourcesByID[aws.ToString(r.Spec.ParentID)]; ok {
+			var err error
+			if parent, err = b.path(p, depth+1); err != nil {
+				return "", err
+			}
+		}
+	}
+	path := strings.TrimSuffix(parent, "/") + "/" + aws.ToString(r.Spec.PathPart)
+	b.paths[r] = path
+	return path, nil
+}
+
+func (b *builder) authorizerName(ref *ackv1alpha1.AWSResourceReferenceWrapper, id *string) (string, error) {
+	for _, a := range b.m.Authorizers {
+		if matches(a, a.Status.ID, ref, id) {
+			return aws.ToString(a.Spec.Name), nil
+		}
+	}
+	return "", errors.New("authorizer not found")
+}
+
+func (b *builder) requestValidatorName(ref *ackv1alpha1.AWSResourceReferenceWrapper, id *string) (string, error) {
+	for _, v := range b.m.RequestValidators {
+		if matches(v, v.Status.ID, ref, id) {
+			return aws.ToString(v.Spec.Name), nil
+		}
+	}
+	return "", errors.New("request validator not found")
+}
+
+// matches returns whether a reference, or else an ID, points to an object
+// with the given ID.
+func matches(obj client.Object, objID *string, ref *ackv1alpha1.AWSResourceReferenceWrapper, id *string) bool {
+	if ref != nil && ref.From != nil {
+		return aws.ToString(ref.From.Name) == obj.GetName()
+	}
+	return id != nil && objID != nil && *id == *objID
+}
+
+func setString(m map[string]interface{}, key string, value *string) {
+	if value != nil && *value != "" {
+		m[key] = *value
+	}
+}
+
+func lowerOrNil(s *string) *string {
+	if s == nil {
+		return nil
+	}
+	return aws.String(strings.ToLower(*s))
+}
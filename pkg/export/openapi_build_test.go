@@ -0,0 +1,152 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package export_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	svcapitypes "github.com/aws-controllers-k8s/apigateway-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/apigateway-controller/pkg/export"
+)
+
+func TestBuildOpenAPI_RoundTrip(t *testing.T) {
+	converted, err := export.ConvertOpenAPI([]byte(swaggerDocument), export.ConvertOptions{
+		Namespace:      "pets",
+		RootResourceID: "root",
+	})
+	require.NoError(t, err)
+	var buf bytes.Buffer
+	require.NoError(t, export.WriteYAML(&buf, converted.Objects()))
+
+	objs, err := export.ReadYAML(&buf)
+	require.NoError(t, err)
+	m, err := export.SelectRestAPI(objs, "")
+	require.NoError(t, err)
+	doc, err := export.BuildOpenAPI(m)
+	require.NoError(t, err)
+
+	out, err := json.Marshal(doc)
+	require.NoError(t, err)
+	assert.Contains(t, string(out), `"openapi":"3.0.1"`)
+
+	again, err := export.ConvertOpenAPI(out, export.ConvertOptions{
+		Namespace:      "pets",
+		RootResourceID: "root",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, converted.RestAPI.Spec, again.RestAPI.Spec)
+	require.Len(t, again.Models, len(converted.Models))
+	assert.JSONEq(t, *converted.Models[0].Spec.Schema, *again.Models[0].Spec.Schema)
+	assert.Equal(t, converted.RequestValidators, again.RequestValidators)
+	assert.Equal(t, converted.Authorizers, again.Authorizers)
+	assert.Equal(t, converted.Resources, again.Resources)
+	assert.Equal(t, converted.Methods, again.Methods)
+	assert.Equal(t, converted.MethodResponses, again.MethodResponses)
+	assert.Equal(t, converted.Integrations, again.Integrations)
+	assert.Equal(t, converted.IntegrationResponses, again.IntegrationResponses)
+}
+
+func TestBuildOpenAPI_IDs(t *testing.T) {
+	meta := func(name string) metav1.ObjectMeta {
+		return metav1.ObjectMeta{Name: name, Namespace: "default"}
+	}
+	restAPI := &svcapitypes.RestAPI{ObjectMeta: meta("api"), Spec: svcapitypes.RestAPISpec{Name: aws.String("api")}}
+	restAPI.Status.ID = aws.String("api1")
+	orders := &svcapitypes.Resource{ObjectMeta: meta("orders"), Spec: svcapitypes.ResourceSpec{
+		ParentID:  aws.String("root"),
+		PathPart:  aws.String("orders"),
+		RestAPIID: aws.String("api1"),
+	}}
+	orders.Status.ID = aws.String("res1")
+	auth := &svcapitypes.Authorizer{ObjectMeta: meta("auth"), Spec: svcapitypes.AuthorizerSpec{
+		Name:           aws.String("jwt"),
+		IdentitySource: aws.String("method.request.header.Authorization"),
+		RestAPIID:      aws.String("api1"),
+		Type:           aws.String("REQUEST"),
+	}}
+	auth.Status.ID = aws.String("auth1")
+	objs := []client.Object{
+		restAPI, orders, auth,
+		&svcapitypes.Method{ObjectMeta: meta("get"), Spec: svcapitypes.MethodSpec{
+			AuthorizationType: aws.String("CUSTOM"),
+			AuthorizerID:      aws.String("auth1"),
+			HTTPMethod:        aws.String("GET"),
+			RequestModels:     map[string]*string{"application/json": aws.String("Empty")},
+			ResourceID:        aws.String("res1"),
+			RestAPIID:         aws.String("api1"),
+		}},
+		// Belongs to another REST API.
+		&svcapitypes.Method{ObjectMeta: meta("other"), Spec: svcapitypes.MethodSpec{
+			HTTPMethod: aws.String("GET"),
+			ResourceID: aws.String("res1"),
+			RestAPIID:  aws.String("api2"),
+		}},
+	}
+
+	m, err := export.SelectRestAPI(objs, "api")
+	require.NoError(t, err)
+	require.Len(t, m.Methods, 1)
+	doc, err := export.BuildOpenAPI(m)
+	require.NoError(t, err)
+
+	out, err := json.Marshal(doc)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"openapi": "3.0.1",
+		"info": {"title": "api", "version": "1.0"},
+		"paths": {"/orders": {"get": {
+			"responses": {},
+			"security": [{"jwt": []}],
+			"requestBody": {"content": {"application/json": {"schema": {"$ref": "#/components/schemas/Empty"}}}}
+		}}},
+		"components": {
+			"schemas": {"Empty": {"$schema": "http://json-schema.org/draft-04/schema#", "title": "Empty Schema", "type": "object"}},
+			"securitySchemes": {"jwt": {
+				"type": "apiKey",
+				"name": "Authorization",
+				"in": "header",
+				"x-amazon-apigateway-authorizer": {"type": "request", "identitySource": "method.request.header.Authorization"}
+			}}
+		}
+	}`, string(out))
+}
+
+func TestReadYAML(t *testing.T) {
+	objs, err := export.ReadYAML(strings.NewReader(`
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: skipped
+---
+apiVersion: apigateway.services.k8s.aws/v1alpha1
+kind: Model
+metadata:
+  name: pet
+spec:
+  name: Pet
+  contentType: application/json
+`))
+	require.NoError(t, err)
+	require.Len(t, objs, 1)
+	assert.Equal(t, "Pet", *objs[0].(*svcapitypes.Model).Spec.Name)
+}
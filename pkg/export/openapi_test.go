@@ -0,0 +1,255 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package export_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aws-controllers-k8s/apigateway-controller/pkg/export"
+)
+
+const swaggerDocument = `
+swagger: "2.0"
+info:
+  title: Pet Store
+  version: "1.0"
+x-amazon-apigateway-request-validators:
+  all:
+    validateRequestBody: true
+    validateRequestParameters: true
+  params:
+    validateRequestParameters: true
+x-amazon-apigateway-request-validator: params
+securityDefinitions:
+  api_key:
+    type: apiKey
+    name: x-api-key
+    in: header
+  token:
+    type: apiKey
+    name: Authorization
+    in: header
+    x-amazon-apigateway-authtype: custom
+    x-amazon-apigateway-authorizer:
+      type: token
+      authorizerUri: arn:aws:apigateway:us-west-2:lambda:path/2015-03-31/functions/arn:aws:lambda:us-west-2:123456789012:function:auth/invocations
+      authorizerResultTtlInSeconds: 300
+definitions:
+  Pet:
+    type: object
+    properties:
+      name:
+        type: string
+paths:
+  /:
+    get:
+      responses:
+        "200":
+          description: OK
+      x-amazon-apigateway-integration:
+        type: mock
+        requestTemplates:
+          application/json: '{"statusCode": 200}'
+        responses:
+          default:
+            statusCode: 200
+  /pets/{petId}:
+    parameters:
+    - name: petId
+      in: path
+      required: true
+      type: string
+    post:
+      security:
+      - token: []
+        api_key: []
+      x-amazon-apigateway-request-validator: all
+      parameters:
+      - name: pet
+        in: body
+        schema:
+          $ref: "#/definitions/Pet"
+      responses:
+        "200":
+          description: OK
+          headers:
+            Access-Control-Allow-Origin:
+              type: string
+          schema:
+            $ref: "#/definitions/Pet"
+        default:
+          description: Error
+      x-amazon-apigateway-integration:
+        type: http
+        httpMethod: post
+        uri: https://example.com/pets/{petId}
+        requestParameters:
+          integration.request.path.petId: method.request.path.petId
+        responses:
+          "4\\d{2}":
+            statusCode: "400"
+          default:
+            statusCode: "200"
+`
+
+func TestConvertOpenAPI_Swagger(t *testing.T) {
+	m, err := export.ConvertOpenAPI([]byte(swaggerDocument), export.ConvertOptions{
+		Namespace:      "pets",
+		RootResourceID: "root",
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "pet-store", m.RestAPI.Name)
+	assert.Equal(t, "pets", m.RestAPI.Namespace)
+	assert.Empty(t, m.RestAPI.Annotations)
+
+	require.Len(t, m.Models, 1)
+	assert.Equal(t, "Pet", *m.Models[0].Spec.Name)
+	assert.JSONEq(t, `{"type": "object", "properties": {"name": {"type": "string"}}}`, *m.Models[0].Spec.Schema)
+
+	require.Len(t, m.RequestValidators, 2)
+	assert.Equal(t, "pet-store-all", m.RequestValidators[0].Name)
+	assert.False(t, *m.RequestValidators[1].Spec.ValidateRequestBody)
+
+	require.Len(t, m.Authorizers, 1)
+	assert.Equal(t, "TOKEN", *m.Authorizers[0].Spec.Type)
+	assert.Equal(t, "method.request.header.Authorization", *m.Authorizers[0].Spec.IdentitySource)
+	assert.Equal(t, int64(300), *m.Authorizers[0].Spec.AuthorizerResultTTLInSeconds)
+
+	require.Len(t, m.Resources, 2)
+	assert.Equal(t, "pet-store-pets", m.Resources[0].Name)
+	assert.Equal(t, "root", *m.Resources[0].Spec.ParentID)
+	assert.Equal(t, "{petId}", *m.Resources[1].Spec.PathPart)
+	assert.Equal(t, "pet-store-pets", *m.Resources[1].Spec.ParentRef.From.Name)
+
+	require.Len(t, m.Methods, 2)
+	root := m.Methods[0]
+	assert.Equal(t, "pet-store-get", root.Name)
+	assert.Equal(t, "root", *root.Spec.ResourceID)
+	assert.Equal(t, "NONE", *root.Spec.AuthorizationType)
+	assert.Equal(t, "pet-store-params", *root.Spec.RequestValidatorRef.From.Name)
+
+	post := m.Methods[1]
+	assert.Equal(t, "pet-store-pets-petid-post", post.Name)
+	assert.Nil(t, post.Spec.ResourceID)
+	assert.Equal(t, "pet-store-pets-petid", *post.Spec.ResourceRef.From.Name)
+	assert.Equal(t, "CUSTOM", *post.Spec.AuthorizationType)
+	assert.Equal(t, "pet-store-token", *post.Spec.AuthorizerRef.From.Name)
+	assert.True(t, *post.Spec.APIKeyRequired)
+	assert.Equal(t, "pet-store-all", *post.Spec.RequestValidatorRef.From.Name)
+	assert.True(t, *post.Spec.RequestParameters["method.request.path.petId"])
+	assert.Equal(t, "Pet", *post.Spec.RequestModels["application/json"])
+
+	require.Len(t, m.MethodResponses, 2)
+	assert.Equal(t, "Pet", *m.MethodResponses[1].Spec.ResponseModels["application/json"])
+	assert.False(t, *m.MethodResponses[1].Spec.ResponseParameters["method.response.header.Access-Control-Allow-Origin"])
+
+	require.Len(t, m.Integrations, 2)
+	assert.Equal(t, "MOCK", *m.Integrations[0].Spec.Type)
+	assert.Equal(t, "HTTP", *m.Integrations[1].Spec.Type)
+	assert.Equal(t, "POST", *m.Integrations[1].Spec.IntegrationHTTPMethod)
+
+	require.Len(t, m.IntegrationResponses, 3)
+	assert.Equal(t, "200", *m.IntegrationResponses[0].Spec.StatusCode)
+	assert.Nil(t, m.IntegrationResponses[0].Spec.SelectionPattern)
+	assert.Equal(t, "400", *m.IntegrationResponses[1].Spec.StatusCode)
+	assert.Equal(t, `4\d{2}`, *m.IntegrationResponses[1].Spec.SelectionPattern)
+
+	var buf bytes.Buffer
+	require.NoError(t, export.WriteYAML(&buf, m.Objects()))
+	assert.Contains(t, buf.String(), "kind: Model\n")
+	assert.Contains(t, buf.String(), "kind: RequestValidator\n")
+}
+
+const openAPIDocument = `{
+  "openapi": "3.0.1",
+  "info": {"title": "Orders"},
+  "components": {
+    "schemas": {"Order": {"type": "object"}},
+    "securitySchemes": {
+      "cognito": {
+        "type": "apiKey",
+        "name": "Authorization",
+        "in": "header",
+        "x-amazon-apigateway-authorizer": {
+          "type": "cognito_user_pools",
+          "providerARNs": ["arn:aws:cognito-idp:us-west-2:123456789012:userpool/us-west-2_abc"]
+        }
+      },
+      "sigv4": {"type": "apiKey", "name": "Authorization", "in": "header", "x-amazon-apigateway-authtype": "awsSigv4"}
+    }
+  },
+  "paths": {
+    "/orders": {
+      "post": {
+        "security": [{"cognito": ["orders/write"]}],
+        "requestBody": {"content": {"application/json": {"schema": {"$ref": "#/components/schemas/Order"}}}},
+        "responses": {"201": {"content": {"application/json": {"schema": {"$ref": "#/components/schemas/Order"}}}}},
+        "x-amazon-apigateway-integration": {"type": "aws_proxy", "httpMethod": "POST", "uri": "arn:aws:apigateway:us-west-2:lambda:path/functions/orders/invocations"}
+      },
+      "get": {
+        "security": [{"sigv4": []}],
+        "parameters": [{"name": "limit", "in": "query"}]
+      }
+    }
+  }
+}`
+
+func TestConvertOpenAPI_OpenAPI3(t *testing.T) {
+	m, err := export.ConvertOpenAPI([]byte(openAPIDocument), export.ConvertOptions{RootResourceID: "root"})
+	require.NoError(t, err)
+
+	require.Len(t, m.Models, 1)
+	require.Len(t, m.Authorizers, 1)
+	assert.Equal(t, "COGNITO_USER_POOLS", *m.Authorizers[0].Spec.Type)
+	assert.Equal(t, "method.request.header.Authorization", *m.Authorizers[0].Spec.IdentitySource)
+
+	require.Len(t, m.Methods, 2)
+	get, post := m.Methods[0], m.Methods[1]
+	assert.Equal(t, "AWS_IAM", *get.Spec.AuthorizationType)
+	assert.False(t, *get.Spec.RequestParameters["method.request.querystring.limit"])
+	assert.Nil(t, get.Spec.RequestValidatorRef)
+
+	assert.Equal(t, "COGNITO_USER_POOLS", *post.Spec.AuthorizationType)
+	assert.Equal(t, "orders/write", *post.Spec.AuthorizationScopes[0])
+	assert.Equal(t, "Order", *post.Spec.RequestModels["application/json"])
+
+	require.Len(t, m.MethodResponses, 1)
+	assert.Equal(t, "Order", *m.MethodResponses[0].Spec.ResponseModels["application/json"])
+	require.Len(t, m.Integrations, 1)
+	assert.Equal(t, "AWS_PROXY", *m.Integrations[0].Spec.Type)
+}
+
+func TestConvertOpenAPI_Errors(t *testing.T) {
+	_, err := export.ConvertOpenAPI([]byte(`{"info": {"title": "x"}}`), export.ConvertOptions{RootResourceID: "root"})
+	assert.Error(t, err)
+
+	_, err = export.ConvertOpenAPI([]byte(openAPIDocument), export.ConvertOptions{})
+	assert.Error(t, err)
+
+	_, err = export.ConvertOpenAPI([]byte(`
+swagger: "2.0"
+info:
+  title: x
+paths:
+  /:
+    get:
+      x-amazon-apigateway-request-validator: missing
+`), export.ConvertOptions{RootResourceID: "root"})
+	assert.ErrorContains(t, err, `unknown request validator "missing"`)
+}
@@ -0,0 +1,692 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package gateway
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	ctrlrt "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	svcapitypes "github.com/aws-controllers-k8s/apigateway-controller/apis/v1alpha1"
+)
+
+// +kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=gatewayclasses;gateways;httproutes;referencegrants,verbs=get;list;watch
+// +kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=gatewayclasses/status;gateways/status;httproutes/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups="",resources=namespaces,verbs=get;list;watch
+
+// generatedKinds are the kinds of the resources generated for a Gateway that
+// are garbage collected once they are no longer part of the translation.
+// Deployments are garbage collected separately, since the Stage keeps using
+// the previous Deployment until the new one is created.
+var generatedKinds = []string{"Integration", "Method", "Resource", "VPCLink"}
+
+// SetupWithManager registers the controllers translating Gateway API
+// resources with the manager.
+func SetupWithManager(mgr ctrlrt.Manager) error {
+	classReconciler := &gatewayClassReconciler{client: mgr.GetClient()}
+	if err := ctrlrt.NewControllerManagedBy(mgr).
+		Named("gatewayclass").
+		For(newUnstructured(GatewayClassGVK)).
+		Complete(classReconciler); err != nil {
+		return err
+	}
+
+	r := &gatewayReconciler{client: mgr.GetClient()}
+	return ctrlrt.NewControllerManagedBy(mgr).
+		Named("gateway").
+		For(newUnstructured(GatewayGVK)).
+		Owns(&svcapitypes.RestAPI{}).
+		Owns(&svcapitypes.Resource{}).
+		Owns(&svcapitypes.Method{}).
+		Owns(&svcapitypes.Integration{}).
+		Owns(&svcapitypes.VPCLink{}).
+		Owns(&svcapitypes.Deployment{}).
+		Owns(&svcapitypes.Stage{}).
+		Watches(newUnstructured(HTTPRouteGVK), handler.EnqueueRequestsFromMapFunc(r.gatewaysForRoute)).
+		Watches(newUnstructured(GatewayClassGVK), handler.EnqueueRequestsFromMapFunc(r.gatewaysForClass)).
+		Complete(r)
+}
+
+// gatewayClassReconciler accepts the GatewayClasses whose controllerName is
+// ControllerName.
+type gatewayClassReconciler struct {
+	client client.Client
+}
+
+func (r *gatewayClassReconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	obj := newUnstructured(GatewayClassGVK)
+	if err := r.client.Get(ctx, req.NamespacedName, obj); err != nil {
+		return reconcile.Result{}, client.IgnoreNotFound(err)
+	}
+	class := &GatewayClass{}
+	if err := fromUnstructured(obj, class); err != nil {
+		return reconcile.Result{}, err
+	}
+	if class.Spec.ControllerName != ControllerName {
+		return reconcile.Result{}, nil
+	}
+	status := &GatewayClassStatus{}
+	if err := decodeStatus(obj, status); err != nil {
+		return reconcile.Result{}, err
+	}
+	setConditions(&status.Conditions, class.Generation,
+		condition(GatewayClassConditionAccepted, true, GatewayClassReasonAccepted, ""))
+	return reconcile.Result{}, updateStatusIfChanged(ctx, r.client, obj, status)
+}
+
+// gatewayReconciler translates the Gateways of the GatewayClasses implemented
+// by this controller, and the HTTPRoutes attached to them, into resources of
+// this controller, and reports their state in the status of the Gateways and
+// HTTPRoutes.
+type gatewayReconciler struct {
+	client client.Client
+}
+
+func (r *gatewayReconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	obj := newUnstructured(GatewayGVK)
+	if err := r.client.Get(ctx, req.NamespacedName, obj); err != nil {
+		return reconcile.Result{}, client.IgnoreNotFound(err)
+	}
+	gw := &Gateway{}
+	if err := fromUnstructured(obj, gw); err != nil {
+		return reconcile.Result{}, err
+	}
+	if !gw.DeletionTimestamp.IsZero() {
+		// The generated resources are owned by the Gateway and deleted with it.
+		return reconcile.Result{}, nil
+	}
+	if implemented, err := r.implementsClass(ctx, gw.Spec.GatewayClassName); err != nil || !implemented {
+		return reconcile.Result{}, err
+	}
+
+	routeObjs, err := r.listRoutes(ctx)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	attached, parentConditions, err := r.attachRoutes(ctx, gw, routeObjs)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	grants, err := r.listReferenceGrants(ctx)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	programmed, restAPI, err := r.sync(ctx, gw, attached, grants, parentConditions)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	if err := r.updateRouteStatuses(ctx, gw, routeObjs, parentConditions); err != nil {
+		return reconcile.Result{}, err
+	}
+	return reconcile.Result{}, r.updateGatewayStatus(ctx, obj, gw, restAPI, programmed)
+}
+
+// sync applies the resources translated from the Gateway and its routes and
+// returns the Programmed condition of the Gateway and the generated RestAPI.
+// The Accepted and ResolvedRefs conditions of the attached routes are added
+// to parentConditions.
+func (r *gatewayReconciler) sync(
+	ctx context.Context,
+	gw *Gateway,
+	routes []*HTTPRoute,
+	grants []*ReferenceGrant,
+	parentConditions map[types.NamespacedName][]metav1.Condition,
+) (metav1.Condition, *unstructured.Unstructured, error) {
+	restAPI, err := r.apply(ctx, RestAPIFor(gw))
+	if err != nil {
+		return metav1.Condition{}, nil, err
+	}
+	rootResourceID, _, _ := unstructured.NestedString(restAPI.Object, "status", "rootResourceID")
+	t := Translate(gw, routes, grants, rootResourceID)
+	for key, conditions := range t.RouteConditions {
+		if _, ok := parentConditions[key]; ok {
+			parentConditions[key] = conditions
+		}
+	}
+	if !resourceSynced(restAPI) || rootResourceID == "" {
+		return pending("waiting for RestAPI %s", gw.Name), restAPI, nil
+	}
+
+	var generated []client.Object
+	for _, o := range t.VPCLinks {
+		generated = append(generated, o)
+	}
+	for _, o := range t.Resources {
+		generated = append(generated, o)
+	}
+	for _, o := range t.Methods {
+		generated = append(generated, o)
+	}
+	for _, o := range t.Integrations {
+		generated = append(generated, o)
+	}
+	desiredNames := map[string]bool{}
+	var unsynced []string
+	for _, o := range generated {
+		applied, err := r.apply(ctx, o)
+		if err != nil {
+			return metav1.Condition{}, restAPI, err
+		}
+		desiredNames[applied.GetKind()+"/"+applied.GetName()] = true
+		if !resourceSynced(applied) {
+			unsynced = append(unsynced, applied.GetKind()+" "+applied.GetName())
+		}
+	}
+	for _, kind := range generatedKinds {
+		if err := r.deleteStale(ctx, gw, kind, desiredNames); err != nil {
+			return metav1.Condition{}, restAPI, err
+		}
+	}
+	if len(t.Methods) == 0 {
+		return pending("no HTTPRoutes are attached to the Gateway"), restAPI, nil
+	}
+	if len(unsynced) > 0 {
+		sort.Strings(unsynced)
+		return pending("waiting for %s", unsynced[0]), restAPI, nil
+	}
+
+	deployment, err := r.apply(ctx, DeploymentFor(gw, t))
+	if err != nil {
+		return metav1.Condition{}, restAPI, err
+	}
+	deploymentID, _, _ := unstructured.NestedString(deployment.Object, "status", "id")
+	// The Deployment used by the Stage before this translation is kept until
+	// the Stage uses the new one.
+	keep := map[string]bool{"Deployment/" + deployment.GetName(): true}
+	if current, err := r.currentDeployment(ctx, gw); err != nil {
+		return metav1.Condition{}, restAPI, err
+	} else if current != "" {
+		keep["Deployment/"+current] = true
+	}
+	if !resourceSynced(deployment) || deploymentID == "" {
+		return pending("waiting for Deployment %s", deployment.GetName()), restAPI, r.deleteStale(ctx, gw, "Deployment", keep)
+	}
+	stage, err := r.apply(ctx, StageFor(gw, deploymentID))
+	if err != nil {
+		return metav1.Condition{}, restAPI, err
+	}
+	if !resourceSynced(stage) {
+		return pending("waiting for Stage %s", stage.GetName()), restAPI, r.deleteStale(ctx, gw, "Deployment", keep)
+	}
+	keep = map[string]bool{"Deployment/" + deployment.GetName(): true}
+	if err := r.deleteStale(ctx, gw, "Deployment", keep); err != nil {
+		return metav1.Condition{}, restAPI, err
+	}
+	return condition(GatewayConditionProgrammed, true, GatewayReasonProgrammed, ""), restAPI, nil
+}
+
+// currentDeployment returns the name of the Deployment whose ID the Stage of
+// the Gateway uses.
+func (r *gatewayReconciler) currentDeployment(ctx context.Context, gw *Gateway) (string, error) {
+	stage := &svcapitypes.Stage{}
+	if err := r.client.Get(ctx, types.NamespacedName{Namespace: gw.Namespace, Name: gw.Name}, stage); err != nil {
+		return "", client.IgnoreNotFound(err)
+	}
+	deployments := &svcapitypes.DeploymentList{}
+	if err := r.client.List(ctx, deployments, client.InNamespace(gw.Namespace), client.MatchingLabels{LabelGateway: gw.Name}); err != nil {
+		return "", err
+	}
+	for _, d := range deployments.Items {
+		if d.Status.ID != nil && stage.Spec.DeploymentID != nil && *d.Status.ID == *stage.Spec.DeploymentID {
+			return d.Name, nil
+		}
+	}
+	return "", nil
+}
+
+// apply creates or updates a resource generated for a Gateway and returns
+// the resource as stored in the cluster. The spec of an existing resource is
+// only replaced if the generated spec has changed since it was last applied.
+func (r *gatewayReconciler) apply(ctx context.Context, generated client.Object) (*unstructured.Unstructured, error) {
+	gvk, err := apiutil.GVKForObject(generated, r.client.Scheme())
+	if err != nil {
+		return nil, err
+	}
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(generated)
+	if err != nil {
+		return nil, err
+	}
+	desired := &unstructured.Unstructured{Object: content}
+	desired.SetGroupVersionKind(gvk)
+	hash := specHash(content["spec"])
+	desired.SetAnnotations(map[string]string{AnnotationSpecHash: hash})
+	unstructured.RemoveNestedField(desired.Object, "status")
+	unstructured.RemoveNestedField(desired.Object, "metadata", "creationTimestamp")
+
+	existing := newUnstructured(gvk)
+	err = r.client.Get(ctx, client.ObjectKeyFromObject(desired), existing)
+	if apierrors.IsNotFound(err) {
+		return desired, r.client.Create(ctx, desired)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if existing.GetAnnotations()[AnnotationSpecHash] == hash {
+		return existing, nil
+	}
+	existing.Object["spec"] = content["spec"]
+	annotations := existing.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[AnnotationSpecHash] = hash
+	existing.SetAnnotations(annotations)
+	existing.SetLabels(desired.GetLabels())
+	existing.SetOwnerReferences(desired.GetOwnerReferences())
+	return existing, r.client.Update(ctx, existing)
+}
+
+// deleteStale deletes the resources of the supplied kind generated for the
+// Gateway that are not in keep, which holds "Kind/name" keys.
+func (r *gatewayReconciler) deleteStale(ctx context.Context, gw *Gateway, kind string, keep map[string]bool) error {
+	list := &unstructured.UnstructuredList{}
+	list.SetGroupVersionKind(svcapitypes.GroupVersion.WithKind(kind + "List"))
+	if err := r.client.List(ctx, list, client.InNamespace(gw.Namespace), client.MatchingLabels{LabelGateway: gw.Name}); err != nil {
+		return err
+	}
+	for i := range list.Items {
+		item := &list.Items[i]
+		if keep[kind+"/"+item.GetName()] || !item.GetDeletionTimestamp().IsZero() {
+			continue
+		}
+		if err := r.client.Delete(ctx, item); client.IgnoreNotFound(err) != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// implementsClass returns true if the GatewayClass is implemented by this
+// controller.
+func (r *gatewayReconciler) implementsClass(ctx context.Context, name string) (bool, error) {
+	obj := newUnstructured(GatewayClassGVK)
+	if err := r.client.Get(ctx, types.NamespacedName{Name: name}, obj); err != nil {
+		return false, client.IgnoreNotFound(err)
+	}
+	class := &GatewayClass{}
+	if err := fromUnstructured(obj, class); err != nil {
+		return false, err
+	}
+	return class.Spec.ControllerName == ControllerName, nil
+}
+
+func (r *gatewayReconciler) listRoutes(ctx context.Context) ([]*unstructured.Unstructured, error) {
+	list := &unstructured.UnstructuredList{}
+	list.SetGroupVersionKind(HTTPRouteGVK.GroupVersion().WithKind(HTTPRouteGVK.Kind + "List"))
+	if err := r.client.List(ctx, list); err != nil {
+		return nil, err
+	}
+	routes := make([]*unstructured.Unstructured, 0, len(list.Items))
+	for i := range list.Items {
+		routes = append(routes, &list.Items[i])
+	}
+	return routes, nil
+}
+
+// listReferenceGrants returns all ReferenceGrants, or none if the
+// ReferenceGrant CRD is not installed.
+func (r *gatewayReconciler) listReferenceGrants(ctx context.Context) ([]*ReferenceGrant, error) {
+	list := &unstructured.UnstructuredList{}
+	list.SetGroupVersionKind(ReferenceGrantGVK.GroupVersion().WithKind(ReferenceGrantGVK.Kind + "List"))
+	if err := r.client.List(ctx, list); err != nil {
+		if meta.IsNoMatchError(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	grants := make([]*ReferenceGrant, 0, len(list.Items))
+	for i := range list.Items {
+		grant := &ReferenceGrant{}
+		if err := fromUnstructured(&list.Items[i], grant); err != nil {
+			return nil, err
+		}
+		grants = append(grants, grant)
+	}
+	return grants, nil
+}
+
+// attachRoutes returns the routes attached to the Gateway in order of
+// precedence, and the conditions of every route referencing the Gateway.
+// Routes that cannot attach to any listener of the Gateway get an Accepted
+// condition that is False.
+func (r *gatewayReconciler) attachRoutes(
+	ctx context.Context,
+	gw *Gateway,
+	routeObjs []*unstructured.Unstructured,
+) ([]*HTTPRoute, map[types.NamespacedName][]metav1.Condition, error) {
+	var attached []*HTTPRoute
+	parentConditions := map[types.NamespacedName][]metav1.Condition{}
+	for _, obj := range routeObjs {
+		rt := &HTTPRoute{}
+		if err := fromUnstructured(obj, rt); err != nil {
+			return nil, nil, err
+		}
+		key := types.NamespacedName{Namespace: rt.Namespace, Name: rt.Name}
+		var referenced, allowed bool
+		reason := RouteReasonNoMatchingParent
+		for _, ref := range rt.Spec.ParentRefs {
+			if !refersTo(ref, rt.Namespace, gw) {
+				continue
+			}
+			referenced = true
+			refReason, err := r.listenerAllows(ctx, gw, rt, ref)
+			if err != nil {
+				return nil, nil, err
+			}
+			if refReason == "" {
+				allowed = true
+			} else {
+				reason = refReason
+			}
+		}
+		if !referenced {
+			continue
+		}
+		if allowed {
+			attached = append(attached, rt)
+			parentConditions[key] = nil
+			continue
+		}
+		parentConditions[key] = []metav1.Condition{
+			condition(RouteConditionAccepted, false, reason, fmt.Sprintf("no listener of Gateway %s/%s accepts the route", gw.Namespace, gw.Name)),
+			condition(RouteConditionResolvedRefs, true, RouteReasonResolvedRefs, ""),
+		}
+	}
+	sort.SliceStable(attached, func(i, j int) bool {
+		a, b := attached[i], attached[j]
+		if !a.CreationTimestamp.Equal(&b.CreationTimestamp) {
+			return a.CreationTimestamp.Before(&b.CreationTimestamp)
+		}
+		return a.Namespace+"/"+a.Name < b.Namespace+"/"+b.Name
+	})
+	return attached, parentConditions, nil
+}
+
+// listenerAllows returns an empty reason if a listener of the Gateway selected
+// by the parent reference accepts the route, or the reason of the Accepted
+// condition of the route otherwise.
+func (r *gatewayReconciler) listenerAllows(ctx context.Context, gw *Gateway, rt *HTTPRoute, ref ParentReference) (string, error) {
+	reason := RouteReasonNoMatchingParent
+	for _, l := range gw.Spec.Listeners {
+		if ref.SectionName != nil && *ref.SectionName != l.Name {
+			continue
+		}
+		if ref.Port != nil && *ref.Port != l.Port {
+			continue
+		}
+		if l.Protocol != "HTTP" && l.Protocol != "HTTPS" {
+			reason = RouteReasonNotAllowedByListeners
+			continue
+		}
+		from := "Same"
+		var selector *metav1.LabelSelector
+		if l.AllowedRoutes != nil && l.AllowedRoutes.Namespaces != nil {
+			if l.AllowedRoutes.Namespaces.From != nil {
+				from = *l.AllowedRoutes.Namespaces.From
+			}
+			selector = l.AllowedRoutes.Namespaces.Selector
+		}
+		switch from {
+		case "All":
+			return "", nil
+		case "Same":
+			if rt.Namespace == gw.Namespace {
+				return "", nil
+			}
+		case "Selector":
+			matches, err := r.namespaceMatches(ctx, rt.Namespace, selector)
+			if err != nil {
+				return "", err
+			}
+			if matches {
+				return "", nil
+			}
+		}
+		reason = RouteReasonNotAllowedByListeners
+	}
+	return reason, nil
+}
+
+func (r *gatewayReconciler) namespaceMatches(ctx context.Context, namespace string, selector *metav1.LabelSelector) (bool, error) {
+	if selector == nil {
+		return false, nil
+	}
+	s, err := metav1.LabelSelectorAsSelector(selector)
+	if err != nil {
+		return false, nil
+	}
+	ns := &metav1.PartialObjectMetadata{}
+	ns.SetGroupVersionKind(schema.GroupVersionKind{Version: "v1", Kind: "Namespace"})
+	if err := r.client.Get(ctx, types.NamespacedName{Name: namespace}, ns); err != nil {
+		return false, client.IgnoreNotFound(err)
+	}
+	return s.Matches(labels.Set(ns.Labels)), nil
+}
+
+// updateRouteStatuses sets the status of every route referencing the Gateway
+// for each of its parent references to the Gateway.
+func (r *gatewayReconciler) updateRouteStatuses(
+	ctx context.Context,
+	gw *Gateway,
+	routeObjs []*unstructured.Unstructured,
+	parentConditions map[types.NamespacedName][]metav1.Condition,
+) error {
+	for _, obj := range routeObjs {
+		rt := &HTTPRoute{}
+		if err := fromUnstructured(obj, rt); err != nil {
+			return err
+		}
+		conditions, referenced := parentConditions[types.NamespacedName{Namespace: rt.Namespace, Name: rt.Name}]
+		status := &rt.Status
+		// Parent statuses written by this controller for a Gateway the route
+		// no longer references are removed.
+		parents := status.Parents[:0]
+		for _, p := range status.Parents {
+			if p.ControllerName == ControllerName && refersTo(p.ParentRef, rt.Namespace, gw) && !routeReferences(rt, p.ParentRef) {
+				continue
+			}
+			parents = append(parents, p)
+		}
+		status.Parents = parents
+		if referenced {
+			for _, ref := range rt.Spec.ParentRefs {
+				if !refersTo(ref, rt.Namespace, gw) {
+					continue
+				}
+				parent := parentStatus(status, ref)
+				setConditions(&parent.Conditions, rt.Generation, conditions...)
+			}
+		}
+		if err := updateStatusIfChanged(ctx, r.client, obj, status); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// updateGatewayStatus sets the Accepted and Programmed conditions and the
+// address of the Gateway.
+func (r *gatewayReconciler) updateGatewayStatus(
+	ctx context.Context,
+	obj *unstructured.Unstructured,
+	gw *Gateway,
+	restAPI *unstructured.Unstructured,
+	programmed metav1.Condition,
+) error {
+	status := &gw.Status
+	setConditions(&status.Conditions, gw.Generation,
+		condition(GatewayConditionAccepted, true, GatewayReasonAccepted, ""),
+		programmed,
+	)
+	status.Addresses = nil
+	restAPIID, _, _ := unstructured.NestedString(restAPI.Object, "status", "id")
+	region, _, _ := unstructured.NestedString(restAPI.Object, "status", "ackResourceMetadata", "region")
+	if restAPIID != "" && region != "" {
+		addressType := "Hostname"
+		status.Addresses = []GatewayStatusAddress{{
+			Type:  &addressType,
+			Value: fmt.Sprintf("%s.execute-api.%s.amazonaws.com", restAPIID, region),
+		}}
+	}
+	return updateStatusIfChanged(ctx, r.client, obj, status)
+}
+
+// gatewaysForRoute returns the Gateways a route references or has a status
+// for, so that both attaching and detaching routes are reconciled.
+func (r *gatewayReconciler) gatewaysForRoute(ctx context.Context, obj client.Object) []reconcile.Request {
+	u, ok := obj.(*unstructured.Unstructured)
+	if !ok {
+		return nil
+	}
+	rt := &HTTPRoute{}
+	if err := fromUnstructured(u, rt); err != nil {
+		return nil
+	}
+	refs := append([]ParentReference{}, rt.Spec.ParentRefs...)
+	for _, p := range rt.Status.Parents {
+		if p.ControllerName == ControllerName {
+			refs = append(refs, p.ParentRef)
+		}
+	}
+	seen := map[types.NamespacedName]bool{}
+	var requests []reconcile.Request
+	for _, ref := range refs {
+		if !isGatewayRef(ref) {
+			continue
+		}
+		key := types.NamespacedName{Namespace: rt.Namespace, Name: ref.Name}
+		if ref.Namespace != nil && *ref.Namespace != "" {
+			key.Namespace = *ref.Namespace
+		}
+		if !seen[key] {
+			seen[key] = true
+			requests = append(requests, reconcile.Request{NamespacedName: key})
+		}
+	}
+	return requests
+}
+
+// gatewaysForClass returns the Gateways of a GatewayClass.
+func (r *gatewayReconciler) gatewaysForClass(ctx context.Context, obj client.Object) []reconcile.Request {
+	list := &unstructured.UnstructuredList{}
+	list.SetGroupVersionKind(GatewayGVK.GroupVersion().WithKind(GatewayGVK.Kind + "List"))
+	if err := r.client.List(ctx, list); err != nil {
+		return nil
+	}
+	var requests []reconcile.Request
+	for _, item := range list.Items {
+		className, _, _ := unstructured.NestedString(item.Object, "spec", "gatewayClassName")
+		if className == obj.GetName() {
+			requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&item)})
+		}
+	}
+	return requests
+}
+
+// refersTo returns true if a parent reference of a route in the supplied
+// namespace points at the Gateway.
+func refersTo(ref ParentReference, routeNamespace string, gw *Gateway) bool {
+	if !isGatewayRef(ref) || ref.Name != gw.Name {
+		return false
+	}
+	namespace := routeNamespace
+	if ref.Namespace != nil && *ref.Namespace != "" {
+		namespace = *ref.Namespace
+	}
+	return namespace == gw.Namespace
+}
+
+func isGatewayRef(ref ParentReference) bool {
+	return (ref.Group == nil || *ref.Group == GroupVersion.Group) &&
+		(ref.Kind == nil || *ref.Kind == GatewayGVK.Kind)
+}
+
+// routeReferences returns true if the route has the supplied parent
+// reference.
+func routeReferences(rt *HTTPRoute, ref ParentReference) bool {
+	for _, r := range rt.Spec.ParentRefs {
+		if reflect.DeepEqual(r, ref) {
+			return true
+		}
+	}
+	return false
+}
+
+// parentStatus returns the status of the route for the parent reference that
+// is written by this controller, adding it if there is none.
+func parentStatus(status *HTTPRouteStatus, ref ParentReference) *RouteParentStatus {
+	for i := range status.Parents {
+		p := &status.Parents[i]
+		if p.ControllerName == ControllerName && reflect.DeepEqual(p.ParentRef, ref) {
+			return p
+		}
+	}
+	status.Parents = append(status.Parents, RouteParentStatus{
+		ParentRef:      ref,
+		ControllerName: ControllerName,
+	})
+	return &status.Parents[len(status.Parents)-1]
+}
+
+func pending(format string, args ...interface{}) metav1.Condition {
+	return condition(GatewayConditionProgrammed, false, GatewayReasonPending, fmt.Sprintf(format, args...))
+}
+
+func newUnstructured(gvk schema.GroupVersionKind) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(gvk)
+	return obj
+}
+
+// decodeStatus decodes the status of an unstructured object.
+func decodeStatus(obj *unstructured.Unstructured, into interface{}) error {
+	status, _, err := unstructured.NestedMap(obj.Object, "status")
+	if err != nil {
+		return err
+	}
+	return runtime.DefaultUnstructuredConverter.FromUnstructured(status, into)
+}
+
+// updateStatusIfChanged replaces the status of an unstructured object if it
+// differs from the supplied status.
+func updateStatusIfChanged(ctx context.Context, c client.Client, obj *unstructured.Unstructured, status interface{}) error {
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(status)
+	if err != nil {
+		return err
+	}
+	current, _, _ := unstructured.NestedMap(obj.Object, "status")
+	if equality.Semantic.DeepEqual(current, content) {
+		return nil
+	}
+	out := obj.DeepCopy()
+	out.Object["status"] = content
+	return c.Status().Update(ctx, out)
+}
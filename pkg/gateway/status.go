@@ -0,0 +1,85 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package gateway
+
+import (
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// Condition types and reasons defined by the Gateway API.
+const (
+	GatewayClassConditionAccepted = "Accepted"
+	GatewayClassReasonAccepted    = "Accepted"
+
+	GatewayConditionAccepted   = "Accepted"
+	GatewayConditionProgrammed = "Programmed"
+	GatewayReasonAccepted      = "Accepted"
+	GatewayReasonProgrammed    = "Programmed"
+	GatewayReasonPending       = "Pending"
+
+	RouteConditionAccepted           = "Accepted"
+	RouteConditionResolvedRefs       = "ResolvedRefs"
+	RouteReasonAccepted              = "Accepted"
+	RouteReasonNotAllowedByListeners = "NotAllowedByListeners"
+	RouteReasonNoMatchingParent      = "NoMatchingParent"
+	RouteReasonUnsupportedValue      = "UnsupportedValue"
+	RouteReasonResolvedRefs          = "ResolvedRefs"
+	RouteReasonRefNotPermitted       = "RefNotPermitted"
+	RouteReasonInvalidKind           = "InvalidKind"
+	RouteReasonBackendNotFound       = "BackendNotFound"
+)
+
+// condition returns a condition without observed generation or transition
+// time, which are filled in when the condition is set on an object.
+func condition(conditionType string, status bool, reason, message string) metav1.Condition {
+	c := metav1.Condition{
+		Type:    conditionType,
+		Status:  metav1.ConditionFalse,
+		Reason:  reason,
+		Message: message,
+	}
+	if status {
+		c.Status = metav1.ConditionTrue
+	}
+	return c
+}
+
+// setConditions sets the supplied conditions with the observed generation of
+// the object they describe.
+func setConditions(conditions *[]metav1.Condition, generation int64, set ...metav1.Condition) {
+	for _, c := range set {
+		c.ObservedGeneration = generation
+		meta.SetStatusCondition(conditions, c)
+	}
+}
+
+// resourceSynced returns whether the ACK.ResourceSynced condition of a
+// resource of this controller is True.
+func resourceSynced(obj *unstructured.Unstructured) bool {
+	conditions, _, _ := unstructured.NestedSlice(obj.Object, "status", "conditions")
+	for _, c := range conditions {
+		cond, ok := c.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if cond["type"] == string(ackv1alpha1.ConditionTypeResourceSynced) {
+			return cond["status"] == string(corev1.ConditionTrue)
+		}
+	}
+	return false
+}
@@ -0,0 +1,491 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package gateway
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	svcapitypes "github.com/aws-controllers-k8s/apigateway-controller/apis/v1alpha1"
+)
+
+const (
+	pathMatchExact      = "Exact"
+	pathMatchPathPrefix = "PathPrefix"
+
+	// proxyPathPart is the greedy path parameter used to map PathPrefix
+	// matches onto the API Gateway resource tree.
+	proxyPathPart = "{proxy+}"
+	// anyMethod is the API Gateway method matching every HTTP method.
+	anyMethod = "ANY"
+)
+
+// supportedMethods are the HTTP methods of Gateway API method matches that
+// API Gateway can route on.
+var supportedMethods = map[string]bool{
+	"DELETE":  true,
+	"GET":     true,
+	"HEAD":    true,
+	"OPTIONS": true,
+	"PATCH":   true,
+	"POST":    true,
+	"PUT":     true,
+}
+
+// Translation is the set of resources of this controller generated for a
+// Gateway and the HTTPRoutes attached to it.
+type Translation struct {
+	RestAPI      *svcapitypes.RestAPI
+	Resources    []*svcapitypes.Resource
+	Methods      []*svcapitypes.Method
+	Integrations []*svcapitypes.Integration
+	VPCLinks     []*svcapitypes.VPCLink
+	// RouteConditions holds the Accepted and ResolvedRefs conditions of each
+	// translated route.
+	RouteConditions map[types.NamespacedName][]metav1.Condition
+}
+
+// translator accumulates the resources generated for a Gateway.
+type translator struct {
+	gw             *Gateway
+	grants         []*ReferenceGrant
+	rootResourceID string
+	out            *Translation
+
+	resources map[string]bool
+	methods   map[string]bool
+	vpcLinks  map[types.NamespacedName]string
+}
+
+// route is a single API Gateway method generated from an HTTPRoute match.
+type route struct {
+	path     string
+	method   string
+	proxy    bool
+	backend  types.NamespacedName
+	port     int32
+	basePath string
+}
+
+// Translate maps a Gateway and the HTTPRoutes attached to it onto a RestAPI
+// and the Resources, Methods, Integrations and VPCLinks exposing the route
+// backends through it. Routes are expected in order of precedence; when two
+// routes match the same path and method, the first one wins.
+//
+// Path matches become resources of the REST API. A PathPrefix match also adds
+// a greedy {proxy+} resource below the prefix. Method matches become methods,
+// and routes without a method match use the ANY method. Since API Gateway
+// cannot route on header or query parameter values, routes with such matches
+// are not accepted. Each rule is sent to
+// the first Service in its backendRefs through a VPC link targeting the
+// Service's network load balancer.
+func Translate(
+	gw *Gateway,
+	routes []*HTTPRoute,
+	grants []*ReferenceGrant,
+	rootResourceID string,
+) *Translation {
+	t := &translator{
+		gw:             gw,
+		grants:         grants,
+		rootResourceID: rootResourceID,
+		out: &Translation{
+			RestAPI:         RestAPIFor(gw),
+			RouteConditions: map[types.NamespacedName][]metav1.Condition{},
+		},
+		resources: map[string]bool{},
+		methods:   map[string]bool{},
+		vpcLinks:  map[types.NamespacedName]string{},
+	}
+	for _, r := range routes {
+		t.translateRoute(r)
+	}
+	return t.out
+}
+
+// RestAPIFor returns the RestAPI generated for a Gateway.
+func RestAPIFor(gw *Gateway) *svcapitypes.RestAPI {
+	return &svcapitypes.RestAPI{
+		ObjectMeta: objectMeta(gw, gw.Name),
+		Spec: svcapitypes.RestAPISpec{
+			Name:        aws.String(fmt.Sprintf("%s-%s", gw.Namespace, gw.Name)),
+			Description: aws.String(fmt.Sprintf("Gateway %s/%s", gw.Namespace, gw.Name)),
+			EndpointConfiguration: &svcapitypes.EndpointConfiguration{
+				Types: []*string{aws.String("REGIONAL")},
+			},
+		},
+	}
+}
+
+// DeploymentFor returns the Deployment of the REST API generated for a
+// Gateway. The name of the Deployment is derived from the translated methods
+// and integrations, so every change to them results in a new Deployment.
+func DeploymentFor(gw *Gateway, t *Translation) *svcapitypes.Deployment {
+	specs := make([]interface{}, 0, len(t.Methods)+len(t.Integrations))
+	for _, m := range t.Methods {
+		specs = append(specs, m.Spec)
+	}
+	for _, i := range t.Integrations {
+		specs = append(specs, i.Spec)
+	}
+	return &svcapitypes.Deployment{
+		ObjectMeta: objectMeta(gw, resourceName(gw, "deployment", specs)),
+		Spec: svcapitypes.DeploymentSpec{
+			RestAPIRef:  restAPIRef(gw),
+			Description: aws.String(fmt.Sprintf("Gateway %s/%s", gw.Namespace, gw.Name)),
+		},
+	}
+}
+
+// StageFor returns the Stage of the REST API generated for a Gateway. The
+// stage is named after the first listener of the Gateway.
+func StageFor(gw *Gateway, deploymentID string) *svcapitypes.Stage {
+	stageName := "default"
+	if len(gw.Spec.Listeners) > 0 {
+		stageName = gw.Spec.Listeners[0].Name
+	}
+	return &svcapitypes.Stage{
+		ObjectMeta: objectMeta(gw, gw.Name),
+		Spec: svcapitypes.StageSpec{
+			RestAPIRef:   restAPIRef(gw),
+			StageName:    aws.String(stageName),
+			DeploymentID: aws.String(deploymentID),
+		},
+	}
+}
+
+// translateRoute adds the resources generated for an HTTPRoute and records
+// its conditions.
+func (t *translator) translateRoute(r *HTTPRoute) {
+	key := types.NamespacedName{Namespace: r.Namespace, Name: r.Name}
+	if err := validateRoute(r); err != nil {
+		t.out.RouteConditions[key] = []metav1.Condition{
+			condition(RouteConditionAccepted, false, RouteReasonUnsupportedValue, err.Error()),
+			condition(RouteConditionResolvedRefs, true, RouteReasonResolvedRefs, ""),
+		}
+		return
+	}
+	resolvedRefs := condition(RouteConditionResolvedRefs, true, RouteReasonResolvedRefs, "")
+	for _, rule := range r.Spec.Rules {
+		backend, port, reason, err := t.ruleBackend(r, rule)
+		if err != nil {
+			if resolvedRefs.Status == metav1.ConditionTrue {
+				resolvedRefs = condition(RouteConditionResolvedRefs, false, reason, err.Error())
+			}
+			continue
+		}
+		if backend == nil {
+			continue
+		}
+		matches := rule.Matches
+		if len(matches) == 0 {
+			matches = []HTTPRouteMatch{{}}
+		}
+		for _, match := range matches {
+			for _, rt := range routesForMatch(match) {
+				rt.backend = *backend
+				rt.port = port
+				t.addRoute(rt)
+			}
+		}
+	}
+	t.out.RouteConditions[key] = []metav1.Condition{
+		condition(RouteConditionAccepted, true, RouteReasonAccepted, ""),
+		resolvedRefs,
+	}
+}
+
+// validateRoute returns an error if the route uses matches that cannot be
+// mapped onto API Gateway.
+func validateRoute(r *HTTPRoute) error {
+	for _, rule := range r.Spec.Rules {
+		for _, match := range rule.Matches {
+			if match.Path != nil && match.Path.Type != nil &&
+				*match.Path.Type != pathMatchExact && *match.Path.Type != pathMatchPathPrefix {
+				return fmt.Errorf("path match type %s is not supported", *match.Path.Type)
+			}
+			if match.Method != nil && !supportedMethods[*match.Method] {
+				return fmt.Errorf("method %s is not supported", *match.Method)
+			}
+			// API Gateway selects methods by path and HTTP method only, so
+			// requests cannot be routed on header or query parameter values.
+			if len(match.Headers) > 0 {
+				return fmt.Errorf("header matches are not supported")
+			}
+			if len(match.QueryParams) > 0 {
+				return fmt.Errorf("query parameter matches are not supported")
+			}
+		}
+	}
+	return nil
+}
+
+// ruleBackend returns the Service that requests matching the rule are sent
+// to, or nil if the rule has no backend. An error and the ResolvedRefs reason
+// are returned if the backend cannot be used.
+func (t *translator) ruleBackend(r *HTTPRoute, rule HTTPRouteRule) (*types.NamespacedName, int32, string, error) {
+	for _, ref := range rule.BackendRefs {
+		if ref.Weight != nil && *ref.Weight == 0 {
+			continue
+		}
+		if (ref.Group != nil && *ref.Group != "") || (ref.Kind != nil && *ref.Kind != "Service") {
+			return nil, 0, RouteReasonInvalidKind, fmt.Errorf("backendRef %s is not a Service", ref.Name)
+		}
+		if ref.Port == nil {
+			return nil, 0, RouteReasonBackendNotFound, fmt.Errorf("backendRef %s has no port", ref.Name)
+		}
+		backend := types.NamespacedName{Namespace: r.Namespace, Name: ref.Name}
+		if ref.Namespace != nil && *ref.Namespace != "" {
+			backend.Namespace = *ref.Namespace
+		}
+		if backend.Namespace != r.Namespace && !referenceGranted(t.grants, r.Namespace, backend) {
+			return nil, 0, RouteReasonRefNotPermitted, fmt.Errorf(
+				"backendRef %s/%s is not permitted by any ReferenceGrant", backend.Namespace, backend.Name)
+		}
+		return &backend, *ref.Port, "", nil
+	}
+	return nil, 0, "", nil
+}
+
+// referenceGranted returns true if a ReferenceGrant allows HTTPRoutes in the
+// supplied namespace to reference the backend Service.
+func referenceGranted(grants []*ReferenceGrant, routeNamespace string, backend types.NamespacedName) bool {
+	for _, g := range grants {
+		if g.Namespace != backend.Namespace {
+			continue
+		}
+		var fromAllowed, toAllowed bool
+		for _, from := range g.Spec.From {
+			if from.Group == GroupVersion.Group && from.Kind == HTTPRouteGVK.Kind && from.Namespace == routeNamespace {
+				fromAllowed = true
+			}
+		}
+		for _, to := range g.Spec.To {
+			if to.Group == "" && to.Kind == "Service" && (to.Name == nil || *to.Name == backend.Name) {
+				toAllowed = true
+			}
+		}
+		if fromAllowed && toAllowed {
+			return true
+		}
+	}
+	return false
+}
+
+// routesForMatch returns the API Gateway methods generated for a match.
+func routesForMatch(match HTTPRouteMatch) []route {
+	matchType := pathMatchPathPrefix
+	path := "/"
+	if match.Path != nil {
+		if match.Path.Type != nil {
+			matchType = *match.Path.Type
+		}
+		if match.Path.Value != nil {
+			path = *match.Path.Value
+		}
+	}
+	path = "/" + strings.Trim(path, "/")
+	method := anyMethod
+	if match.Method != nil {
+		method = *match.Method
+	}
+
+	rt := route{
+		path:     path,
+		method:   method,
+		basePath: path,
+	}
+	if matchType == pathMatchExact {
+		return []route{rt}
+	}
+	proxy := rt
+	proxy.path = joinPath(path, proxyPathPart)
+	proxy.proxy = true
+	return []route{rt, proxy}
+}
+
+// addRoute adds the resources, method, integration and VPC link of a route,
+// unless a route with the same path and method was added before.
+func (t *translator) addRoute(rt route) {
+	methodKey := rt.method + " " + rt.path
+	if t.methods[methodKey] {
+		return
+	}
+	t.methods[methodKey] = true
+
+	resourceRef := t.addResources(rt.path)
+	vpcLinkName := t.addVPCLink(rt.backend)
+
+	methodParams := map[string]*bool{}
+	integrationParams := map[string]*string{}
+	backendPath := rt.basePath
+	if rt.proxy {
+		methodParams["method.request.path.proxy"] = aws.Bool(true)
+		integrationParams["integration.request.path.proxy"] = aws.String("method.request.path.proxy")
+		backendPath = joinPath(rt.basePath, "{proxy}")
+	}
+	if len(methodParams) == 0 {
+		methodParams = nil
+		integrationParams = nil
+	}
+
+	method := &svcapitypes.Method{
+		ObjectMeta: objectMeta(t.gw, resourceName(t.gw, "method", methodKey)),
+		Spec: svcapitypes.MethodSpec{
+			RestAPIRef:        restAPIRef(t.gw),
+			AuthorizationType: aws.String("NONE"),
+			HTTPMethod:        aws.String(rt.method),
+			RequestParameters: methodParams,
+		},
+	}
+	integration := &svcapitypes.Integration{
+		ObjectMeta: objectMeta(t.gw, resourceName(t.gw, "integration", methodKey)),
+		Spec: svcapitypes.IntegrationSpec{
+			RestAPIRef:            restAPIRef(t.gw),
+			HTTPMethod:            aws.String(rt.method),
+			IntegrationHTTPMethod: aws.String(rt.method),
+			Type:                  aws.String("HTTP_PROXY"),
+			ConnectionRef:         localRef(vpcLinkName),
+			RequestParameters:     integrationParams,
+			ServiceRef: &svcapitypes.IntegrationServiceReference{
+				Name:      aws.String(rt.backend.Name),
+				Namespace: aws.String(rt.backend.Namespace),
+				Port:      aws.Int64(int64(rt.port)),
+				Path:      aws.String(backendPath),
+			},
+		},
+	}
+	if resourceRef == nil {
+		method.Spec.ResourceID = aws.String(t.rootResourceID)
+		integration.Spec.ResourceID = aws.String(t.rootResourceID)
+	} else {
+		method.Spec.ResourceRef = resourceRef
+		integration.Spec.ResourceRef = resourceRef
+	}
+	// The integration can only be put once the method exists, which the
+	// Integration resource cannot observe. Its first attempts fail until the
+	// Method is created and are retried.
+	t.out.Methods = append(t.out.Methods, method)
+	t.out.Integrations = append(t.out.Integrations, integration)
+}
+
+// addResources adds the resources making up a path and returns a reference
+// to the resource of the path, or nil for the root resource.
+func (t *translator) addResources(path string) *ackv1alpha1.AWSResourceReferenceWrapper {
+	var parentRef *ackv1alpha1.AWSResourceReferenceWrapper
+	current := ""
+	for _, part := range strings.Split(strings.Trim(path, "/"), "/") {
+		if part == "" {
+			continue
+		}
+		current = joinPath(current, part)
+		name := resourceName(t.gw, "resource", current)
+		if !t.resources[current] {
+			t.resources[current] = true
+			res := &svcapitypes.Resource{
+				ObjectMeta: objectMeta(t.gw, name),
+				Spec: svcapitypes.ResourceSpec{
+					RestAPIRef: restAPIRef(t.gw),
+					PathPart:   aws.String(part),
+				},
+			}
+			if parentRef == nil {
+				res.Spec.ParentID = aws.String(t.rootResourceID)
+			} else {
+				res.Spec.ParentRef = parentRef
+			}
+			t.out.Resources = append(t.out.Resources, res)
+		}
+		parentRef = localRef(name)
+	}
+	return parentRef
+}
+
+// addVPCLink adds the VPC link targeting the load balancer of a backend
+// Service and returns its name.
+func (t *translator) addVPCLink(backend types.NamespacedName) string {
+	if name, ok := t.vpcLinks[backend]; ok {
+		return name
+	}
+	name := resourceName(t.gw, "vpclink", backend.String())
+	t.vpcLinks[backend] = name
+	t.out.VPCLinks = append(t.out.VPCLinks, &svcapitypes.VPCLink{
+		ObjectMeta: objectMeta(t.gw, name),
+		Spec: svcapitypes.VPCLinkSpec{
+			Name:        aws.String(fmt.Sprintf("%s-%s-%s", t.gw.Namespace, t.gw.Name, backend.Name)),
+			Description: aws.String(fmt.Sprintf("Service %s for Gateway %s/%s", backend, t.gw.Namespace, t.gw.Name)),
+			TargetRefs: []*svcapitypes.VPCLinkTargetReference{{
+				ServiceRef: &svcapitypes.ServiceReference{
+					Name:      aws.String(backend.Name),
+					Namespace: aws.String(backend.Namespace),
+				},
+			}},
+		},
+	})
+	return name
+}
+
+// objectMeta returns the metadata of a resource generated for a Gateway. The
+// resource is labelled with and controlled by the Gateway, so it is garbage
+// collected with it.
+func objectMeta(gw *Gateway, name string) metav1.ObjectMeta {
+	return metav1.ObjectMeta{
+		Name:      name,
+		Namespace: gw.Namespace,
+		Labels:    map[string]string{LabelGateway: gw.Name},
+		OwnerReferences: []metav1.OwnerReference{{
+			APIVersion:         GroupVersion.String(),
+			Kind:               GatewayGVK.Kind,
+			Name:               gw.Name,
+			UID:                gw.UID,
+			Controller:         aws.Bool(true),
+			BlockOwnerDeletion: aws.Bool(true),
+		}},
+	}
+}
+
+func restAPIRef(gw *Gateway) *ackv1alpha1.AWSResourceReferenceWrapper {
+	return localRef(gw.Name)
+}
+
+func localRef(name string) *ackv1alpha1.AWSResourceReferenceWrapper {
+	return &ackv1alpha1.AWSResourceReferenceWrapper{
+		From: &ackv1alpha1.AWSResourceReference{Name: aws.String(name)},
+	}
+}
+
+// resourceName returns a stable name for a resource generated for a Gateway
+// from the kind of resource and the value identifying it.
+func resourceName(gw *Gateway, kind string, id interface{}) string {
+	return fmt.Sprintf("%s-%s", gw.Name, specHash(kind, id)[:10])
+}
+
+// specHash returns the hex encoded SHA-256 of the JSON encoding of values.
+func specHash(values ...interface{}) string {
+	b, _ := json.Marshal(values)
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:])
+}
+
+func joinPath(base, part string) string {
+	return strings.TrimSuffix(base, "/") + "/" + part
+}
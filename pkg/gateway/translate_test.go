@@ -0,0 +1,235 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package gateway_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/aws-controllers-k8s/apigateway-controller/pkg/gateway"
+)
+
+func newGateway() *gateway.Gateway {
+	return &gateway.Gateway{
+		ObjectMeta: metav1.ObjectMeta{Name: "public", Namespace: "infra", UID: "uid"},
+		Spec: gateway.GatewaySpec{
+			GatewayClassName: "apigateway",
+			Listeners:        []gateway.Listener{{Name: "prod", Port: 443, Protocol: "HTTPS"}},
+		},
+	}
+}
+
+func newRoute(namespace string, rules ...gateway.HTTPRouteRule) *gateway.HTTPRoute {
+	return &gateway.HTTPRoute{
+		ObjectMeta: metav1.ObjectMeta{Name: "orders", Namespace: namespace},
+		Spec: gateway.HTTPRouteSpec{
+			ParentRefs: []gateway.ParentReference{{Name: "public", Namespace: aws.String("infra")}},
+			Rules:      rules,
+		},
+	}
+}
+
+func backend(name string, port int32) []gateway.HTTPBackendRef {
+	return []gateway.HTTPBackendRef{{Name: name, Port: aws.Int32(port)}}
+}
+
+func TestTranslatePathPrefix(t *testing.T) {
+	route := newRoute("infra", gateway.HTTPRouteRule{
+		Matches: []gateway.HTTPRouteMatch{{
+			Path:   &gateway.HTTPPathMatch{Type: aws.String("PathPrefix"), Value: aws.String("/orders/")},
+			Method: aws.String("GET"),
+		}},
+		BackendRefs: backend("orders", 8080),
+	})
+
+	out := gateway.Translate(newGateway(), []*gateway.HTTPRoute{route}, nil, "root")
+
+	require.Len(t, out.Resources, 2)
+	assert.Equal(t, "orders", *out.Resources[0].Spec.PathPart)
+	assert.Equal(t, "root", *out.Resources[0].Spec.ParentID)
+	assert.Equal(t, "{proxy+}", *out.Resources[1].Spec.PathPart)
+	assert.Equal(t, out.Resources[0].Name, *out.Resources[1].Spec.ParentRef.From.Name)
+
+	require.Len(t, out.Methods, 2)
+	require.Len(t, out.Integrations, 2)
+	for _, m := range out.Methods {
+		assert.Equal(t, "GET", *m.Spec.HTTPMethod)
+	}
+	assert.Equal(t, out.Resources[0].Name, *out.Methods[0].Spec.ResourceRef.From.Name)
+	assert.Equal(t, "/orders", *out.Integrations[0].Spec.ServiceRef.Path)
+	assert.Equal(t, "/orders/{proxy}", *out.Integrations[1].Spec.ServiceRef.Path)
+	assert.Equal(t, "method.request.path.proxy", *out.Integrations[1].Spec.RequestParameters["integration.request.path.proxy"])
+	assert.Equal(t, int64(8080), *out.Integrations[0].Spec.ServiceRef.Port)
+
+	require.Len(t, out.VPCLinks, 1)
+	assert.Equal(t, out.VPCLinks[0].Name, *out.Integrations[0].Spec.ConnectionRef.From.Name)
+	assert.Equal(t, "orders", *out.VPCLinks[0].Spec.TargetRefs[0].ServiceRef.Name)
+
+	for _, o := range []metav1.Object{out.RestAPI, out.Resources[0], out.Methods[0], out.VPCLinks[0]} {
+		assert.Equal(t, "infra", o.GetNamespace())
+		assert.Equal(t, "public", o.GetLabels()[gateway.LabelGateway])
+		assert.Equal(t, types.UID("uid"), o.GetOwnerReferences()[0].UID)
+	}
+
+	conditions := out.RouteConditions[types.NamespacedName{Namespace: "infra", Name: "orders"}]
+	require.Len(t, conditions, 2)
+	assert.Equal(t, metav1.ConditionTrue, conditions[0].Status)
+	assert.Equal(t, metav1.ConditionTrue, conditions[1].Status)
+}
+
+func TestTranslateExactRootMatch(t *testing.T) {
+	route := newRoute("infra", gateway.HTTPRouteRule{
+		Matches: []gateway.HTTPRouteMatch{{
+			Path: &gateway.HTTPPathMatch{Type: aws.String("Exact"), Value: aws.String("/")},
+		}},
+		BackendRefs: backend("web", 80),
+	})
+
+	out := gateway.Translate(newGateway(), []*gateway.HTTPRoute{route}, nil, "root")
+
+	assert.Empty(t, out.Resources)
+	require.Len(t, out.Methods, 1)
+	assert.Equal(t, "ANY", *out.Methods[0].Spec.HTTPMethod)
+	assert.Equal(t, "root", *out.Methods[0].Spec.ResourceID)
+	assert.Nil(t, out.Methods[0].Spec.RequestParameters)
+}
+
+func TestTranslateFirstRouteWins(t *testing.T) {
+	match := []gateway.HTTPRouteMatch{{
+		Path: &gateway.HTTPPathMatch{Type: aws.String("Exact"), Value: aws.String("/orders")},
+	}}
+	first := newRoute("infra", gateway.HTTPRouteRule{Matches: match, BackendRefs: backend("v1", 80)})
+	second := newRoute("infra", gateway.HTTPRouteRule{Matches: match, BackendRefs: backend("v2", 80)})
+	second.Name = "orders-v2"
+
+	out := gateway.Translate(newGateway(), []*gateway.HTTPRoute{first, second}, nil, "root")
+
+	require.Len(t, out.Integrations, 1)
+	assert.Equal(t, "v1", *out.Integrations[0].Spec.ServiceRef.Name)
+}
+
+func TestTranslateRouteConditions(t *testing.T) {
+	for _, tt := range []struct {
+		description string
+		route       *gateway.HTTPRoute
+		grants      []*gateway.ReferenceGrant
+
+		expectedAccepted     metav1.ConditionStatus
+		expectedResolvedRefs metav1.ConditionStatus
+		expectedReason       string
+	}{
+		{
+			description: "regular expression path match",
+			route: newRoute("infra", gateway.HTTPRouteRule{
+				Matches: []gateway.HTTPRouteMatch{{
+					Path: &gateway.HTTPPathMatch{Type: aws.String("RegularExpression"), Value: aws.String("/o.*")},
+				}},
+				BackendRefs: backend("orders", 80),
+			}),
+			expectedAccepted:     metav1.ConditionFalse,
+			expectedResolvedRefs: metav1.ConditionTrue,
+			expectedReason:       gateway.RouteReasonUnsupportedValue,
+		},
+		{
+			description: "header match",
+			route: newRoute("infra", gateway.HTTPRouteRule{
+				Matches: []gateway.HTTPRouteMatch{{
+					Headers: []gateway.HTTPHeaderMatch{{Name: "x-tenant", Value: "a"}},
+				}},
+				BackendRefs: backend("orders", 80),
+			}),
+			expectedAccepted:     metav1.ConditionFalse,
+			expectedResolvedRefs: metav1.ConditionTrue,
+			expectedReason:       gateway.RouteReasonUnsupportedValue,
+		},
+		{
+			description: "query parameter match",
+			route: newRoute("infra", gateway.HTTPRouteRule{
+				Matches: []gateway.HTTPRouteMatch{{
+					QueryParams: []gateway.HTTPQueryParamMatch{{Name: "tenant", Value: "a"}},
+				}},
+				BackendRefs: backend("orders", 80),
+			}),
+			expectedAccepted:     metav1.ConditionFalse,
+			expectedResolvedRefs: metav1.ConditionTrue,
+			expectedReason:       gateway.RouteReasonUnsupportedValue,
+		},
+		{
+			description: "cross namespace backend without ReferenceGrant",
+			route: newRoute("team", gateway.HTTPRouteRule{
+				BackendRefs: []gateway.HTTPBackendRef{{Name: "orders", Namespace: aws.String("shop"), Port: aws.Int32(80)}},
+			}),
+			expectedAccepted:     metav1.ConditionTrue,
+			expectedResolvedRefs: metav1.ConditionFalse,
+			expectedReason:       gateway.RouteReasonRefNotPermitted,
+		},
+		{
+			description: "cross namespace backend with ReferenceGrant",
+			route: newRoute("team", gateway.HTTPRouteRule{
+				BackendRefs: []gateway.HTTPBackendRef{{Name: "orders", Namespace: aws.String("shop"), Port: aws.Int32(80)}},
+			}),
+			grants: []*gateway.ReferenceGrant{{
+				ObjectMeta: metav1.ObjectMeta{Name: "team", Namespace: "shop"},
+				Spec: gateway.ReferenceGrantSpec{
+					From: []gateway.ReferenceGrantFrom{{Group: "gateway.networking.k8s.io", Kind: "HTTPRoute", Namespace: "team"}},
+					To:   []gateway.ReferenceGrantTo{{Kind: "Service"}},
+				},
+			}},
+			expectedAccepted:     metav1.ConditionTrue,
+			expectedResolvedRefs: metav1.ConditionTrue,
+			expectedReason:       gateway.RouteReasonResolvedRefs,
+		},
+		{
+			description: "backend that is not a Service",
+			route: newRoute("infra", gateway.HTTPRouteRule{
+				BackendRefs: []gateway.HTTPBackendRef{{Name: "bucket", Kind: aws.String("Bucket"), Group: aws.String("s3"), Port: aws.Int32(80)}},
+			}),
+			expectedAccepted:     metav1.ConditionTrue,
+			expectedResolvedRefs: metav1.ConditionFalse,
+			expectedReason:       gateway.RouteReasonInvalidKind,
+		},
+	} {
+		t.Run(tt.description, func(t *testing.T) {
+			out := gateway.Translate(newGateway(), []*gateway.HTTPRoute{tt.route}, tt.grants, "root")
+
+			conditions := out.RouteConditions[types.NamespacedName{Namespace: tt.route.Namespace, Name: tt.route.Name}]
+			require.Len(t, conditions, 2)
+			assert.Equal(t, tt.expectedAccepted, conditions[0].Status)
+			assert.Equal(t, tt.expectedResolvedRefs, conditions[1].Status)
+			if tt.expectedAccepted == metav1.ConditionFalse {
+				assert.Equal(t, tt.expectedReason, conditions[0].Reason)
+			} else {
+				assert.Equal(t, tt.expectedReason, conditions[1].Reason)
+			}
+		})
+	}
+}
+
+func TestDeploymentForChangesWithTranslation(t *testing.T) {
+	gw := newGateway()
+	rule := gateway.HTTPRouteRule{BackendRefs: backend("orders", 80)}
+	a := gateway.DeploymentFor(gw, gateway.Translate(gw, []*gateway.HTTPRoute{newRoute("infra", rule)}, nil, "root"))
+	b := gateway.DeploymentFor(gw, gateway.Translate(gw, []*gateway.HTTPRoute{newRoute("infra", rule)}, nil, "root"))
+	rule.BackendRefs = backend("orders", 8080)
+	c := gateway.DeploymentFor(gw, gateway.Translate(gw, []*gateway.HTTPRoute{newRoute("infra", rule)}, nil, "root"))
+
+	assert.Equal(t, a.Name, b.Name)
+	assert.NotEqual(t, a.Name, c.Name)
+	assert.Equal(t, "prod", *gateway.StageFor(gw, "dep").Spec.StageName)
+}
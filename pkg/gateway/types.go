@@ -0,0 +1,241 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package gateway
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// This controller does not depend on the Gateway API module. The types below
+// are the subset of the gateway.networking.k8s.io/v1 API that the translator
+// reads, decoded from unstructured objects.
+
+const (
+	// ControllerName is the value of spec.controllerName of the GatewayClasses
+	// whose Gateways are implemented by this controller.
+	ControllerName = "apigateway.services.k8s.aws/gateway-controller"
+
+	// LabelGateway is set on every resource generated for a Gateway to the
+	// name of that Gateway.
+	LabelGateway = "apigateway.services.k8s.aws/gateway"
+	// AnnotationSpecHash records the hash of the generated spec of a resource,
+	// so that fields filled in by the controller managing the resource are not
+	// reverted on every translation.
+	AnnotationSpecHash = "apigateway.services.k8s.aws/gateway-spec-hash"
+)
+
+// GroupVersion is the API group version of the Gateway API resources.
+var GroupVersion = schema.GroupVersion{
+	Group:   "gateway.networking.k8s.io",
+	Version: "v1",
+}
+
+var (
+	// GatewayClassGVK identifies the Gateway API GatewayClass resource.
+	GatewayClassGVK = GroupVersion.WithKind("GatewayClass")
+	// GatewayGVK identifies the Gateway API Gateway resource.
+	GatewayGVK = GroupVersion.WithKind("Gateway")
+	// HTTPRouteGVK identifies the Gateway API HTTPRoute resource.
+	HTTPRouteGVK = GroupVersion.WithKind("HTTPRoute")
+	// ReferenceGrantGVK identifies the Gateway API ReferenceGrant resource.
+	ReferenceGrantGVK = schema.GroupVersionKind{
+		Group:   GroupVersion.Group,
+		Version: "v1beta1",
+		Kind:    "ReferenceGrant",
+	}
+)
+
+// GatewayClass is a gateway.networking.k8s.io/v1 GatewayClass.
+type GatewayClass struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              GatewayClassSpec `json:"spec"`
+}
+
+// GatewayClassSpec is the spec of a GatewayClass.
+type GatewayClassSpec struct {
+	ControllerName string `json:"controllerName"`
+}
+
+// GatewayClassStatus is the status of a GatewayClass.
+type GatewayClassStatus struct {
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// Gateway is a gateway.networking.k8s.io/v1 Gateway.
+type Gateway struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              GatewaySpec   `json:"spec"`
+	Status            GatewayStatus `json:"status,omitempty"`
+}
+
+// GatewaySpec is the spec of a Gateway.
+type GatewaySpec struct {
+	GatewayClassName string     `json:"gatewayClassName"`
+	Listeners        []Listener `json:"listeners"`
+}
+
+// Listener is a listener of a Gateway.
+type Listener struct {
+	Name          string         `json:"name"`
+	Hostname      *string        `json:"hostname,omitempty"`
+	Port          int32          `json:"port"`
+	Protocol      string         `json:"protocol"`
+	AllowedRoutes *AllowedRoutes `json:"allowedRoutes,omitempty"`
+}
+
+// AllowedRoutes restricts the routes that can attach to a listener.
+type AllowedRoutes struct {
+	Namespaces *RouteNamespaces `json:"namespaces,omitempty"`
+}
+
+// RouteNamespaces restricts the namespaces of the routes that can attach to a
+// listener.
+type RouteNamespaces struct {
+	From     *string               `json:"from,omitempty"`
+	Selector *metav1.LabelSelector `json:"selector,omitempty"`
+}
+
+// GatewayStatus is the status of a Gateway.
+type GatewayStatus struct {
+	Addresses  []GatewayStatusAddress `json:"addresses,omitempty"`
+	Conditions []metav1.Condition     `json:"conditions,omitempty"`
+}
+
+// GatewayStatusAddress is an address of a Gateway.
+type GatewayStatusAddress struct {
+	Type  *string `json:"type,omitempty"`
+	Value string  `json:"value"`
+}
+
+// HTTPRoute is a gateway.networking.k8s.io/v1 HTTPRoute.
+type HTTPRoute struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              HTTPRouteSpec   `json:"spec"`
+	Status            HTTPRouteStatus `json:"status,omitempty"`
+}
+
+// HTTPRouteSpec is the spec of an HTTPRoute.
+type HTTPRouteSpec struct {
+	ParentRefs []ParentReference `json:"parentRefs,omitempty"`
+	Hostnames  []string          `json:"hostnames,omitempty"`
+	Rules      []HTTPRouteRule   `json:"rules,omitempty"`
+}
+
+// ParentReference identifies the Gateway, and optionally the listener, that
+// a route attaches to.
+type ParentReference struct {
+	Group       *string `json:"group,omitempty"`
+	Kind        *string `json:"kind,omitempty"`
+	Namespace   *string `json:"namespace,omitempty"`
+	Name        string  `json:"name"`
+	SectionName *string `json:"sectionName,omitempty"`
+	Port        *int32  `json:"port,omitempty"`
+}
+
+// HTTPRouteRule is a rule of an HTTPRoute.
+type HTTPRouteRule struct {
+	Matches     []HTTPRouteMatch `json:"matches,omitempty"`
+	BackendRefs []HTTPBackendRef `json:"backendRefs,omitempty"`
+}
+
+// HTTPRouteMatch is a match of an HTTPRoute rule.
+type HTTPRouteMatch struct {
+	Path        *HTTPPathMatch        `json:"path,omitempty"`
+	Headers     []HTTPHeaderMatch     `json:"headers,omitempty"`
+	QueryParams []HTTPQueryParamMatch `json:"queryParams,omitempty"`
+	Method      *string               `json:"method,omitempty"`
+}
+
+// HTTPPathMatch matches the path of a request.
+type HTTPPathMatch struct {
+	Type  *string `json:"type,omitempty"`
+	Value *string `json:"value,omitempty"`
+}
+
+// HTTPHeaderMatch matches a header of a request.
+type HTTPHeaderMatch struct {
+	Type  *string `json:"type,omitempty"`
+	Name  string  `json:"name"`
+	Value string  `json:"value"`
+}
+
+// HTTPQueryParamMatch matches a query parameter of a request.
+type HTTPQueryParamMatch struct {
+	Type  *string `json:"type,omitempty"`
+	Name  string  `json:"name"`
+	Value string  `json:"value"`
+}
+
+// HTTPBackendRef is a backend of an HTTPRoute rule.
+type HTTPBackendRef struct {
+	Group     *string `json:"group,omitempty"`
+	Kind      *string `json:"kind,omitempty"`
+	Name      string  `json:"name"`
+	Namespace *string `json:"namespace,omitempty"`
+	Port      *int32  `json:"port,omitempty"`
+	Weight    *int32  `json:"weight,omitempty"`
+}
+
+// HTTPRouteStatus is the status of an HTTPRoute.
+type HTTPRouteStatus struct {
+	Parents []RouteParentStatus `json:"parents,omitempty"`
+}
+
+// RouteParentStatus is the status of a route with respect to one of its
+// parents.
+type RouteParentStatus struct {
+	ParentRef      ParentReference    `json:"parentRef"`
+	ControllerName string             `json:"controllerName"`
+	Conditions     []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// ReferenceGrant is a gateway.networking.k8s.io/v1beta1 ReferenceGrant.
+type ReferenceGrant struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              ReferenceGrantSpec `json:"spec"`
+}
+
+// ReferenceGrantSpec is the spec of a ReferenceGrant.
+type ReferenceGrantSpec struct {
+	From []ReferenceGrantFrom `json:"from"`
+	To   []ReferenceGrantTo   `json:"to"`
+}
+
+// ReferenceGrantFrom identifies the resources allowed to reference the
+// resources in the namespace of a ReferenceGrant.
+type ReferenceGrantFrom struct {
+	Group     string `json:"group"`
+	Kind      string `json:"kind"`
+	Namespace string `json:"namespace"`
+}
+
+// ReferenceGrantTo identifies the resources that may be referenced.
+type ReferenceGrantTo struct {
+	Group string  `json:"group"`
+	Kind  string  `json:"kind"`
+	Name  *string `json:"name,omitempty"`
+}
+
+// fromUnstructured decodes an unstructured Gateway API object into one of the
+// types above.
+func fromUnstructured(obj *unstructured.Unstructured, into interface{}) error {
+	return runtime.DefaultUnstructuredConverter.FromUnstructured(obj.UnstructuredContent(), into)
+}
@@ -0,0 +1,296 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+// Package observe implements the observe-only mode of the controller, in which
+// API Gateway resources are only read: their status is filled and the
+// differences between their spec and the observed resources are reported in
+// a Drifted condition, but nothing is created, updated, tagged or deleted.
+package observe
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+	ackcondition "github.com/aws-controllers-k8s/runtime/pkg/condition"
+	ackcfg "github.com/aws-controllers-k8s/runtime/pkg/config"
+	ackmetrics "github.com/aws-controllers-k8s/runtime/pkg/metrics"
+	ackrequeue "github.com/aws-controllers-k8s/runtime/pkg/requeue"
+	acktypes "github.com/aws-controllers-k8s/runtime/pkg/types"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/aws-controllers-k8s/apigateway-controller/pkg/util"
+)
+
+// AnnotationObserveOnly is the annotation enabling ("true") or disabling
+// ("false") the observe-only mode for a single resource, whatever the mode of
+// the controller.
+const AnnotationObserveOnly = "apigateway.services.k8s.aws/observe-only"
+
+// ConditionTypeDrifted indicates whether the spec of a resource in
+// observe-only mode differs from the observed API Gateway resource.
+const ConditionTypeDrifted ackv1alpha1.ConditionType = "Drifted"
+
+// ConditionReasonObserveOnly is the reason of the conditions set on the
+// resources in observe-only mode.
+const ConditionReasonObserveOnly = "ObserveOnly"
+
+// RequeueAfter is the delay after which a resource in observe-only mode that
+// does not exist is looked up again.
+const RequeueAfter = 5 * time.Minute
+
+// ErrNotFound is returned, wrapped in a requeue error, by the resource
+// managers when a resource in observe-only mode does not exist.
+var ErrNotFound = errors.New("observe only: resource does not exist")
+
+type observeOnlyKey struct{}
+
+// Enabled returns whether a resource is only observed, given the mode of the
+// controller.
+func Enabled(res acktypes.AWSResource, controllerDefault bool) bool {
+	if v, ok := res.MetaObject().GetAnnotations()[AnnotationObserveOnly]; ok {
+		if enabled, err := strconv.ParseBool(v); err == nil {
+			return enabled
+		}
+	}
+	return controllerDefault
+}
+
+// WrapManagerFactories returns resource manager factories whose managers
+// only observe the resources in observe-only mode. enabled is the mode of the
+// controller, which resources override with the AnnotationObserveOnly
+// annotation.
+func WrapManagerFactories(
+	factories []acktypes.AWSResourceManagerFactory,
+	enabled bool,
+) []acktypes.AWSResourceManagerFactory {
+	wrapped := make([]acktypes.AWSResourceManagerFactory, 0, len(factories))
+	for _, f := range factories {
+		wrapped = append(wrapped, &managerFactory{AWSResourceManagerFactory: f, enabled: enabled})
+	}
+	return wrapped
+}
+
+type managerFactory struct {
+	acktypes.AWSResourceManagerFactory
+	enabled bool
+}
+
+// ManagerFor returns a resource manager whose API Gateway client refuses the
+// mutating calls made on behalf of resources in observe-only mode.
+func (f *managerFactory) ManagerFor(
+	cfg ackcfg.Config,
+	clientcfg aws.Config,
+	log logr.Logger,
+	metrics *ackmetrics.Metrics,
+	rr acktypes.Reconciler,
+	id ackv1alpha1.AWSAccountID,
+	region ackv1alpha1.AWSRegion,
+	roleARN ackv1alpha1.AWSResourceName,
+) (acktypes.AWSResourceManager, error) {
+	clientcfg.APIOptions = append(slices.Clone(clientcfg.APIOptions), addMiddleware)
+	rm, err := f.AWSResourceManagerFactory.ManagerFor(cfg, clientcfg, log, metrics, rr, id, region, roleARN)
+	if err != nil {
+		return nil, err
+	}
+	return &resourceManager{
+		AWSResourceManager: rm,
+		rd:                 f.ResourceDescriptor(),
+		enabled:            f.enabled,
+	}, nil
+}
+
+type resourceManager struct {
+	acktypes.AWSResourceManager
+	rd      acktypes.AWSResourceDescriptor
+	enabled bool
+}
+
+// ReadOne returns the observed resource, with a Drifted condition listing the
+// spec fields that differ from the observed ones for a resource in
+// observe-only mode.
+func (rm *resourceManager) ReadOne(
+	ctx context.Context,
+	res acktypes.AWSResource,
+) (acktypes.AWSResource, error) {
+	if !Enabled(res, rm.enabled) {
+		return rm.AWSResourceManager.ReadOne(ctx, res)
+	}
+	latest, err := rm.AWSResourceManager.ReadOne(withObserveOnly(ctx), res)
+	if err != nil {
+		return latest, err
+	}
+	paths := specPaths(rm.rd.Delta(res, latest))
+	if len(paths) == 0 {
+		setDrifted(latest, corev1.ConditionFalse, "spec matches the observed resource")
+		return latest, nil
+	}
+	message := "spec differs from the observed resource at: " + strings.Join(paths, ", ")
+	setDrifted(latest, corev1.ConditionTrue, message)
+	reason := ConditionReasonObserveOnly
+	ackcondition.SetSynced(latest, corev1.ConditionFalse, &message, &reason)
+	return latest, nil
+}
+
+// Create reports that a resource in observe-only mode does not exist instead
+// of creating it.
+func (rm *resourceManager) Create(
+	ctx context.Context,
+	res acktypes.AWSResource,
+) (acktypes.AWSResource, error) {
+	if !Enabled(res, rm.enabled) {
+		return rm.AWSResourceManager.Create(ctx, res)
+	}
+	missing := res.DeepCopy()
+	message := ErrNotFound.Error()
+	reason := ConditionReasonObserveOnly
+	setDrifted(missing, corev1.ConditionTrue, message)
+	ackcondition.SetSynced(missing, corev1.ConditionFalse, &message, &reason)
+	return missing, ackrequeue.NeededAfter(ErrNotFound, RequeueAfter)
+}
+
+// Update leaves a resource in observe-only mode as observed. The returned
+// resource has the desired spec, so that the runtime does not replace it with
+// the observed one, and the observed status.
+func (rm *resourceManager) Update(
+	ctx context.Context,
+	desired acktypes.AWSResource,
+	latest acktypes.AWSResource,
+	delta *ackcompare.Delta,
+) (acktypes.AWSResource, error) {
+	if !Enabled(desired, rm.enabled) {
+		return rm.AWSResourceManager.Update(ctx, desired, latest, delta)
+	}
+	observed := desired.DeepCopy()
+	observed.SetStatus(latest)
+	return observed, nil
+}
+
+// Delete leaves the API Gateway resource of a resource in observe-only mode in
+// place, so that only the Kubernetes resource is deleted.
+func (rm *resourceManager) Delete(
+	ctx context.Context,
+	res acktypes.AWSResource,
+) (acktypes.AWSResource, error) {
+	if !Enabled(res, rm.enabled) {
+		return rm.AWSResourceManager.Delete(ctx, res)
+	}
+	return nil, nil
+}
+
+// EnsureTags leaves out the default tags of the controller from the spec of a
+// resource in observe-only mode, which would otherwise be reported as drift
+// on every resource the controller did not create.
+func (rm *resourceManager) EnsureTags(
+	ctx context.Context,
+	res acktypes.AWSResource,
+	md acktypes.ServiceControllerMetadata,
+) error {
+	if !Enabled(res, rm.enabled) {
+		return rm.AWSResourceManager.EnsureTags(ctx, res, md)
+	}
+	return nil
+}
+
+// LateInitialize is a no-op for a resource in observe-only mode, whose spec
+// is left as written.
+func (rm *resourceManager) LateInitialize(
+	ctx context.Context,
+	res acktypes.AWSResource,
+) (acktypes.AWSResource, error) {
+	if !Enabled(res, rm.enabled) {
+		return rm.AWSResourceManager.LateInitialize(ctx, res)
+	}
+	return res, nil
+}
+
+// setDrifted sets the Drifted condition of a resource.
+func setDrifted(res acktypes.AWSResource, status corev1.ConditionStatus, message string) {
+	conditions := res.Conditions()
+	var c *ackv1alpha1.Condition
+	if c = ackcondition.FirstOfType(res, ConditionTypeDrifted); c == nil {
+		c = &ackv1alpha1.Condition{Type: ConditionTypeDrifted}
+		conditions = append(conditions, c)
+	}
+	now := metav1.Now()
+	c.LastTransitionTime = &now
+	c.Status = status
+	c.Message = &message
+	c.Reason = aws.String(ConditionReasonObserveOnly)
+	res.ReplaceConditions(conditions)
+}
+
+// specPaths returns the dotted paths of the spec fields that differ in delta.
+// The fields left unset in the spec, to which API Gateway gives default
+// values, are not drift.
+func specPaths(delta *ackcompare.Delta) []string {
+	var paths []string
+	for _, d := range delta.Differences {
+		if ackcompare.IsNil(d.A) {
+			continue
+		}
+		// Path does not expose its parts but through its JSON encoding.
+		raw, err := json.Marshal(d.Path)
+		if err != nil {
+			continue
+		}
+		var path struct{ Parts []string }
+		if err := json.Unmarshal(raw, &path); err != nil || len(path.Parts) == 0 || path.Parts[0] != "Spec" {
+			continue
+		}
+		paths = append(paths, strings.Join(path.Parts, "."))
+	}
+	return paths
+}
+
+// ObserveOnly returns whether ctx is the context of the calls made on behalf of
+// a resource in observe-only mode, which must not modify anything.
+func ObserveOnly(ctx context.Context) bool {
+	observeOnly, _ := ctx.Value(observeOnlyKey{}).(bool)
+	return observeOnly
+}
+
+func withObserveOnly(ctx context.Context) context.Context {
+	return context.WithValue(ctx, observeOnlyKey{}, true)
+}
+
+// addMiddleware adds to the middleware stack of the API Gateway client a
+// middleware refusing the mutating calls made on behalf of resources in
+// observe-only mode, should a resource manager attempt any while reading.
+func addMiddleware(stack *middleware.Stack) error {
+	refuse := middleware.InitializeMiddlewareFunc("ObserveOnlyRefuse", func(
+		ctx context.Context,
+		in middleware.InitializeInput,
+		next middleware.InitializeHandler,
+	) (middleware.InitializeOutput, middleware.Metadata, error) {
+		operation := awsmiddleware.GetOperationName(ctx)
+		if ObserveOnly(ctx) && !util.IsReadOperation(operation) {
+			return middleware.InitializeOutput{}, middleware.Metadata{},
+				fmt.Errorf("observe only: refusing to call %s", operation)
+		}
+		return next.HandleInitialize(ctx, in)
+	})
+	return stack.Initialize.Add(refuse, middleware.After)
+}
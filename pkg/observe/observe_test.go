@@ -0,0 +1,151 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package observe_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	ackcondition "github.com/aws-controllers-k8s/runtime/pkg/condition"
+	ackcfg "github.com/aws-controllers-k8s/runtime/pkg/config"
+	ackmetrics "github.com/aws-controllers-k8s/runtime/pkg/metrics"
+	acktypes "github.com/aws-controllers-k8s/runtime/pkg/types"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	svcapitypes "github.com/aws-controllers-k8s/apigateway-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/apigateway-controller/pkg/observe"
+	svcresource "github.com/aws-controllers-k8s/apigateway-controller/pkg/resource"
+
+	_ "github.com/aws-controllers-k8s/apigateway-controller/pkg/resource/rest_api"
+)
+
+// transport answers the requests for the REST API api1 with its observed
+// state, every other request with an empty response, and records the
+// requests sent.
+type transport struct {
+	sent []string
+}
+
+func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.sent = append(t.sent, req.Method+" "+req.URL.Path)
+	body := "{}"
+	if req.Method == http.MethodGet && req.URL.Path == "/restapis/api1" {
+		body = `{"id": "api1", "name": "api", "description": "observed"}`
+	}
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       io.NopCloser(strings.NewReader(body)),
+		Request:    req,
+	}, nil
+}
+
+var accounts int
+
+func restAPIManager(t *testing.T, enabled bool) (acktypes.AWSResourceManager, acktypes.AWSResourceDescriptor, *transport) {
+	t.Helper()
+	var factory acktypes.AWSResourceManagerFactory
+	for _, f := range observe.WrapManagerFactories(svcresource.GetManagerFactories(), enabled) {
+		if f.ResourceDescriptor().GroupVersionKind().Kind == "RestAPI" {
+			factory = f
+		}
+	}
+	require.NotNil(t, factory)
+
+	tr := &transport{}
+	clientcfg := aws.Config{
+		Region:      "us-west-2",
+		Credentials: credentials.NewStaticCredentialsProvider("AKID", "SECRET", ""),
+		HTTPClient:  &http.Client{Transport: tr},
+	}
+	// The resource managers are cached by account, so that every manager
+	// gets its own client.
+	accounts++
+	rm, err := factory.ManagerFor(ackcfg.Config{}, clientcfg, logr.Discard(), ackmetrics.NewMetrics("apigateway"),
+		nil, ackv1alpha1.AWSAccountID(fmt.Sprintf("%012d", accounts)), "us-west-2", "")
+	require.NoError(t, err)
+	return rm, factory.ResourceDescriptor(), tr
+}
+
+func restAPI(description string, annotations map[string]string) *svcapitypes.RestAPI {
+	return &svcapitypes.RestAPI{
+		ObjectMeta: metav1.ObjectMeta{Name: "api", Namespace: "default", Annotations: annotations},
+		Spec: svcapitypes.RestAPISpec{
+			Name:        aws.String("api"),
+			Description: aws.String(description),
+		},
+		Status: svcapitypes.RestAPIStatus{ID: aws.String("api1")},
+	}
+}
+
+func TestReadOne_Drift(t *testing.T) {
+	rm, rd, tr := restAPIManager(t, true)
+	desired := rd.ResourceFromRuntimeObject(restAPI("desired", nil))
+
+	latest, err := rm.ReadOne(context.Background(), desired)
+	require.NoError(t, err)
+	drifted := ackcondition.FirstOfType(latest, observe.ConditionTypeDrifted)
+	require.NotNil(t, drifted)
+	assert.Equal(t, corev1.ConditionTrue, drifted.Status)
+	assert.Contains(t, *drifted.Message, "Spec.Description")
+	assert.Equal(t, corev1.ConditionFalse, ackcondition.Synced(latest).Status)
+
+	updated, err := rm.Update(context.Background(), desired, latest, rd.Delta(desired, latest))
+	require.NoError(t, err)
+	assert.Equal(t, "desired", *updated.RuntimeObject().(*svcapitypes.RestAPI).Spec.Description)
+	assert.NotNil(t, ackcondition.FirstOfType(updated, observe.ConditionTypeDrifted))
+
+	deleted, err := rm.Delete(context.Background(), desired)
+	require.NoError(t, err)
+	assert.Nil(t, deleted)
+
+	for _, sent := range tr.sent {
+		assert.True(t, strings.HasPrefix(sent, http.MethodGet), sent)
+	}
+}
+
+func TestReadOne_NoDrift(t *testing.T) {
+	rm, rd, _ := restAPIManager(t, true)
+	desired := rd.ResourceFromRuntimeObject(restAPI("observed", nil))
+
+	latest, err := rm.ReadOne(context.Background(), desired)
+	require.NoError(t, err)
+	drifted := ackcondition.FirstOfType(latest, observe.ConditionTypeDrifted)
+	require.NotNil(t, drifted)
+	assert.Equal(t, corev1.ConditionFalse, drifted.Status, *drifted.Message)
+	assert.Nil(t, ackcondition.Synced(latest))
+}
+
+func TestCreate_NotFound(t *testing.T) {
+	rm, rd, tr := restAPIManager(t, false)
+	enabled := map[string]string{observe.AnnotationObserveOnly: "true"}
+	desired := rd.ResourceFromRuntimeObject(restAPI("desired", enabled))
+
+	missing, err := rm.Create(context.Background(), desired)
+	assert.True(t, errors.Is(err, observe.ErrNotFound))
+	assert.Equal(t, corev1.ConditionTrue, ackcondition.FirstOfType(missing, observe.ConditionTypeDrifted).Status)
+	assert.Empty(t, tr.sent)
+}
@@ -0,0 +1,213 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+// Package ratelimit limits the rate of the API Gateway calls of the resource
+// managers with token buckets shared by all the managers of an account and
+// region, one for the calls reading resources and one for the calls mutating
+// them. The rate of a bucket is halved whenever API Gateway throttles a call,
+// and recovers gradually as calls succeed again.
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	ackcfg "github.com/aws-controllers-k8s/runtime/pkg/config"
+	ackmetrics "github.com/aws-controllers-k8s/runtime/pkg/metrics"
+	acktypes "github.com/aws-controllers-k8s/runtime/pkg/types"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	smithy "github.com/aws/smithy-go"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/go-logr/logr"
+	"golang.org/x/time/rate"
+
+	"github.com/aws-controllers-k8s/apigateway-controller/pkg/util"
+)
+
+// ThrottlingErrorCode is the code of the errors of the calls API Gateway
+// throttles.
+const ThrottlingErrorCode = "TooManyRequestsException"
+
+// minRateDivisor bounds how far throttling lowers the rate of a bucket: to
+// its configured rate divided by minRateDivisor.
+const minRateDivisor = 16
+
+// recoverySteps is the number of successful calls after which a bucket
+// throttled once is back to its configured rate.
+const recoverySteps = 10
+
+// Config is the budget of the API Gateway calls of an account and region.
+// A rate of 0 does not limit the calls.
+type Config struct {
+	// ReadRate is the number of calls reading resources per second.
+	ReadRate float64
+	// ReadBurst is the number of calls reading resources that can be made at
+	// once.
+	ReadBurst int
+	// MutateRate is the number of calls creating, updating or deleting
+	// resources per second.
+	MutateRate float64
+	// MutateBurst is the number of calls creating, updating or deleting
+	// resources that can be made at once.
+	MutateBurst int
+}
+
+// Limiters holds the budgets of the accounts and regions the resource
+// managers call API Gateway in.
+type Limiters struct {
+	cfg     Config
+	mu      sync.Mutex
+	budgets map[string]*Budget
+}
+
+// NewLimiters returns Limiters giving each account and region the budget
+// cfg.
+func NewLimiters(cfg Config) *Limiters {
+	return &Limiters{cfg: cfg, budgets: map[string]*Budget{}}
+}
+
+// For returns the budget of an account and region.
+func (l *Limiters) For(id ackv1alpha1.AWSAccountID, region ackv1alpha1.AWSRegion) *Budget {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	key := string(id) + "/" + string(region)
+	b, ok := l.budgets[key]
+	if !ok {
+		b = &Budget{
+			read:   newBucket(l.cfg.ReadRate, l.cfg.ReadBurst),
+			mutate: newBucket(l.cfg.MutateRate, l.cfg.MutateBurst),
+		}
+		l.budgets[key] = b
+	}
+	return b
+}
+
+// Budget is the pair of token buckets of the calls of an account and region.
+type Budget struct {
+	read   *bucket
+	mutate *bucket
+}
+
+// Limit returns the current rate of the calls of the bucket of an operation,
+// lowered from the configured one while API Gateway throttles the calls.
+func (b *Budget) Limit(operation string) rate.Limit {
+	return b.bucketFor(operation).limiter.Limit()
+}
+
+func (b *Budget) bucketFor(operation string) *bucket {
+	if util.IsReadOperation(operation) {
+		return b.read
+	}
+	return b.mutate
+}
+
+// AddMiddleware adds to the middleware stack of an API Gateway client the
+// middleware taking a token from the budget before each attempt of a call,
+// retries included, and adapting the rate of the bucket to its outcome.
+func (b *Budget) AddMiddleware(stack *middleware.Stack) error {
+	limit := middleware.FinalizeMiddlewareFunc("RateLimit", func(
+		ctx context.Context,
+		in middleware.FinalizeInput,
+		next middleware.FinalizeHandler,
+	) (middleware.FinalizeOutput, middleware.Metadata, error) {
+		bk := b.bucketFor(awsmiddleware.GetOperationName(ctx))
+		if err := bk.limiter.Wait(ctx); err != nil {
+			return middleware.FinalizeOutput{}, middleware.Metadata{}, err
+		}
+		out, md, err := next.HandleFinalize(ctx, in)
+		var apiErr smithy.APIError
+		if errors.As(err, &apiErr) && apiErr.ErrorCode() == ThrottlingErrorCode {
+			bk.throttled()
+		} else if err == nil {
+			bk.succeeded()
+		}
+		return out, md, err
+	})
+	return stack.Finalize.Insert(limit, "Retry", middleware.After)
+}
+
+// bucket is a token bucket whose rate adapts to the throttling of the calls:
+// it is halved on each throttled call, down to a minimum, and increases back
+// to the configured rate in recoverySteps successful calls.
+type bucket struct {
+	limiter *rate.Limiter
+	max     rate.Limit
+	mu      sync.Mutex
+}
+
+func newBucket(r float64, burst int) *bucket {
+	if r <= 0 {
+		return &bucket{limiter: rate.NewLimiter(rate.Inf, 0), max: rate.Inf}
+	}
+	burst = max(burst, 1)
+	return &bucket{limiter: rate.NewLimiter(rate.Limit(r), burst), max: rate.Limit(r)}
+}
+
+func (b *bucket) throttled() {
+	if b.max == rate.Inf {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.limiter.SetLimit(max(b.limiter.Limit()/2, b.max/minRateDivisor))
+}
+
+func (b *bucket) succeeded() {
+	if b.max == rate.Inf {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if l := b.limiter.Limit(); l < b.max {
+		b.limiter.SetLimit(min(l+b.max/recoverySteps, b.max))
+	}
+}
+
+// WrapManagerFactories returns resource manager factories whose managers take
+// the API Gateway calls they make from the budgets of limiters.
+func WrapManagerFactories(
+	factories []acktypes.AWSResourceManagerFactory,
+	limiters *Limiters,
+) []acktypes.AWSResourceManagerFactory {
+	wrapped := make([]acktypes.AWSResourceManagerFactory, 0, len(factories))
+	for _, f := range factories {
+		wrapped = append(wrapped, &managerFactory{AWSResourceManagerFactory: f, limiters: limiters})
+	}
+	return wrapped
+}
+
+type managerFactory struct {
+	acktypes.AWSResourceManagerFactory
+	limiters *Limiters
+}
+
+// ManagerFor returns a resource manager whose API Gateway client takes its
+// calls from the budget of the account and region.
+func (f *managerFactory) ManagerFor(
+	cfg ackcfg.Config,
+	clientcfg aws.Config,
+	log logr.Logger,
+	metrics *ackmetrics.Metrics,
+	rr acktypes.Reconciler,
+	id ackv1alpha1.AWSAccountID,
+	region ackv1alpha1.AWSRegion,
+	roleARN ackv1alpha1.AWSResourceName,
+) (acktypes.AWSResourceManager, error) {
+	budget := f.limiters.For(id, region)
+	clientcfg.APIOptions = append(slices.Clone(clientcfg.APIOptions), budget.AddMiddleware)
+	return f.AWSResourceManagerFactory.ManagerFor(cfg, clientcfg, log, metrics, rr, id, region, roleARN)
+}
@@ -0,0 +1,127 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package ratelimit_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	svcsdk "github.com/aws/aws-sdk-go-v2/service/apigateway"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/time/rate"
+
+	"github.com/aws-controllers-k8s/apigateway-controller/pkg/ratelimit"
+)
+
+// transport answers the requests with a throttling error while throttle is
+// set, and with an empty API Gateway response otherwise.
+type transport struct {
+	throttle bool
+	sent     int
+}
+
+func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.sent++
+	if t.throttle {
+		return &http.Response{
+			StatusCode: http.StatusTooManyRequests,
+			Header: http.Header{
+				"Content-Type":     []string{"application/json"},
+				"X-Amzn-Errortype": []string{ratelimit.ThrottlingErrorCode},
+			},
+			Body:    io.NopCloser(strings.NewReader(`{"message":"Too Many Requests"}`)),
+			Request: req,
+		}, nil
+	}
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       io.NopCloser(strings.NewReader("{}")),
+		Request:    req,
+	}, nil
+}
+
+func client(budget *ratelimit.Budget, tr *transport) *svcsdk.Client {
+	return svcsdk.NewFromConfig(aws.Config{
+		Region:           "us-west-2",
+		Credentials:      credentials.NewStaticCredentialsProvider("AKID", "SECRET", ""),
+		HTTPClient:       &http.Client{Transport: tr},
+		RetryMaxAttempts: 1,
+		APIOptions:       []func(*middleware.Stack) error{budget.AddMiddleware},
+	})
+}
+
+func TestLimiters_For(t *testing.T) {
+	limiters := ratelimit.NewLimiters(ratelimit.Config{ReadRate: 5, ReadBurst: 10, MutateRate: 2, MutateBurst: 5})
+
+	budget := limiters.For("111111111111", "us-west-2")
+	assert.Same(t, budget, limiters.For("111111111111", "us-west-2"))
+	assert.NotSame(t, budget, limiters.For("111111111111", "eu-west-1"))
+	assert.NotSame(t, budget, limiters.For("222222222222", "us-west-2"))
+
+	assert.Equal(t, rate.Limit(5), budget.Limit("GetRestApis"))
+	assert.Equal(t, rate.Limit(2), budget.Limit("CreateRestApi"))
+}
+
+func TestBudget_Throttling(t *testing.T) {
+	limiters := ratelimit.NewLimiters(ratelimit.Config{ReadRate: 100, ReadBurst: 100, MutateRate: 100, MutateBurst: 100})
+	budget := limiters.For("111111111111", "us-west-2")
+	tr := &transport{throttle: true}
+	c := client(budget, tr)
+
+	_, err := c.GetRestApis(context.Background(), &svcsdk.GetRestApisInput{})
+	require.Error(t, err)
+	assert.Equal(t, rate.Limit(50), budget.Limit("GetRestApis"))
+	assert.Equal(t, rate.Limit(100), budget.Limit("CreateRestApi"), "mutating calls have their own budget")
+
+	for range 10 {
+		_, _ = c.GetRestApis(context.Background(), &svcsdk.GetRestApisInput{})
+	}
+	assert.Equal(t, rate.Limit(100.0/16), budget.Limit("GetRestApis"), "throttling lowers the rate to a minimum")
+
+	tr.throttle = false
+	for range 20 {
+		_, err = c.GetRestApis(context.Background(), &svcsdk.GetRestApisInput{})
+		require.NoError(t, err)
+	}
+	assert.Equal(t, rate.Limit(100), budget.Limit("GetRestApis"), "successful calls restore the rate")
+}
+
+func TestBudget_Wait(t *testing.T) {
+	limiters := ratelimit.NewLimiters(ratelimit.Config{ReadRate: 0.01, ReadBurst: 1})
+	budget := limiters.For("111111111111", "us-west-2")
+	tr := &transport{}
+	c := client(budget, tr)
+
+	_, err := c.GetRestApis(context.Background(), &svcsdk.GetRestApisInput{})
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err = c.GetRestApis(ctx, &svcsdk.GetRestApisInput{})
+	assert.Error(t, err, "the read budget is spent")
+	assert.Equal(t, 1, tr.sent)
+
+	_, err = c.CreateRestApi(context.Background(), &svcsdk.CreateRestApiInput{Name: aws.String("api")})
+	assert.NoError(t, err, "a rate of 0 does not limit the mutating calls")
+	assert.Equal(t, 2, tr.sent)
+}
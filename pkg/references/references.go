@@ -0,0 +1,174 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package references
+
+import (
+	"context"
+	"strings"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// The generated references.go files resolve references into typed objects of
+// other ACK service controllers (e.g. ec2apitypes.VPCEndpoint). This controller
+// does not depend on the API modules of every controller it can reference, so
+// the helpers below read those resources as unstructured objects instead, while
+// applying the same Terminal/ResourceSynced checks as the generated code.
+
+var (
+	// CognitoUserPoolGVK identifies the UserPool resource of the ACK Cognito
+	// Identity Provider controller.
+	CognitoUserPoolGVK = schema.GroupVersionKind{
+		Group:   "cognitoidentityprovider.services.k8s.aws",
+		Version: "v1alpha1",
+		Kind:    "UserPool",
+	}
+	// IAMRoleGVK identifies the Role resource of the ACK IAM controller.
+	IAMRoleGVK = schema.GroupVersionKind{
+		Group:   "iam.services.k8s.aws",
+		Version: "v1alpha1",
+		Kind:    "Role",
+	}
+	// ELBv2GroupVersion is the API group version of the ACK Elastic Load
+	// Balancing v2 controller.
+	ELBv2GroupVersion = schema.GroupVersion{
+		Group:   "elbv2.services.k8s.aws",
+		Version: "v1alpha1",
+	}
+)
+
+// ResolveARN reads the referenced ACK resource and returns the value of its
+// Status.ACKResourceMetadata.ARN field.
+func ResolveARN(
+	ctx context.Context,
+	apiReader client.Reader,
+	gvk schema.GroupVersionKind,
+	name string,
+	namespace string,
+) (*string, error) {
+	return ResolveField(ctx, apiReader, gvk, name, namespace, "Status.ACKResourceMetadata.ARN")
+}
+
+// ResolveField reads the referenced ACK resource, verifies that it exists and
+// is in a ACK.ResourceSynced=True state, and returns the string value found at
+// the supplied field path (e.g. "Status.ACKResourceMetadata.ARN").
+func ResolveField(
+	ctx context.Context,
+	apiReader client.Reader,
+	gvk schema.GroupVersionKind,
+	name string, // the Kubernetes name of the referenced resource
+	namespace string, // the Kubernetes namespace of the referenced resource
+	path string,
+) (*string, error) {
+	obj, err := GetSyncedResource(ctx, apiReader, gvk, name, namespace)
+	if err != nil {
+		return nil, err
+	}
+	val, found, err := unstructured.NestedString(obj.Object, jsonFieldPath(path)...)
+	if err != nil || !found || val == "" {
+		return nil, ackerr.ResourceReferenceMissingTargetFieldFor(
+			gvk.Kind,
+			namespace, name,
+			path)
+	}
+	return &val, nil
+}
+
+// GetSyncedResource looks up whether a referenced resource exists and is in a
+// ACK.ResourceSynced=True state. If the referenced resource does exist and is
+// in a Synced state, it is returned, otherwise returns
+// `ackerr.ResourceReferenceTerminalFor` or `ResourceReferenceNotSyncedFor`
+// depending on if the resource is in a Terminal state.
+func GetSyncedResource(
+	ctx context.Context,
+	apiReader client.Reader,
+	gvk schema.GroupVersionKind,
+	name string,
+	namespace string,
+) (*unstructured.Unstructured, error) {
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(gvk)
+	namespacedName := types.NamespacedName{
+		Namespace: namespace,
+		Name:      name,
+	}
+	if err := apiReader.Get(ctx, namespacedName, obj); err != nil {
+		return nil, err
+	}
+	conditions, _, _ := unstructured.NestedSlice(obj.Object, "status", "conditions")
+	var refResourceSynced bool
+	for _, c := range conditions {
+		cond, ok := c.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		condType, _ := cond["type"].(string)
+		condStatus, _ := cond["status"].(string)
+		if condType == string(ackv1alpha1.ConditionTypeTerminal) &&
+			condStatus == string(corev1.ConditionTrue) {
+			return nil, ackerr.ResourceReferenceTerminalFor(
+				gvk.Kind,
+				namespace, name)
+		}
+		if condType == string(ackv1alpha1.ConditionTypeResourceSynced) &&
+			condStatus == string(corev1.ConditionTrue) {
+			refResourceSynced = true
+		}
+	}
+	if !refResourceSynced {
+		return nil, ackerr.ResourceReferenceNotSyncedFor(
+			gvk.Kind,
+			namespace, name)
+	}
+	return obj, nil
+}
+
+// jsonFieldPath converts a Go field path such as "Status.ACKResourceMetadata.ARN"
+// into the JSON field path used by the resource, e.g.
+// ["status", "ackResourceMetadata", "arn"].
+func jsonFieldPath(path string) []string {
+	parts := strings.Split(path, ".")
+	fields := make([]string, 0, len(parts))
+	for _, part := range parts {
+		fields = append(fields, lowerFirstWord(part))
+	}
+	return fields
+}
+
+// lowerFirstWord lowercases the leading run of upper case letters of a Go
+// field name the same way ACK names JSON fields: "ARN" -> "arn",
+// "ACKResourceMetadata" -> "ackResourceMetadata", "LoadBalancerARN" ->
+// "loadBalancerARN".
+func lowerFirstWord(name string) string {
+	runes := []rune(name)
+	i := 0
+	for i < len(runes) && runes[i] >= 'A' && runes[i] <= 'Z' {
+		i++
+	}
+	switch {
+	case i == 0:
+		return name
+	case i == 1, i == len(runes):
+		return strings.ToLower(string(runes[:i])) + string(runes[i:])
+	default:
+		// The last upper case letter starts the next word.
+		return strings.ToLower(string(runes[:i-1])) + string(runes[i-1:])
+	}
+}
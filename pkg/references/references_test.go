@@ -0,0 +1,117 @@
+package references_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/aws-controllers-k8s/apigateway-controller/pkg/references"
+)
+
+func newUserPool(name string, conditions []interface{}, arn string) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{Object: map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"name":      name,
+			"namespace": "default",
+		},
+		"status": map[string]interface{}{
+			"conditions": conditions,
+		},
+	}}
+	obj.SetGroupVersionKind(references.CognitoUserPoolGVK)
+	if arn != "" {
+		_ = unstructured.SetNestedField(obj.Object, arn, "status", "ackResourceMetadata", "arn")
+	}
+	return obj
+}
+
+func TestResolveARN(t *testing.T) {
+	synced := []interface{}{map[string]interface{}{"type": "ACK.ResourceSynced", "status": "True"}}
+	terminal := []interface{}{map[string]interface{}{"type": "ACK.Terminal", "status": "True"}}
+
+	for _, tt := range []struct {
+		description string
+		object      *unstructured.Unstructured
+
+		expectedARN   string
+		expectedError string
+	}{
+		{
+			description: "synced resource with ARN",
+			object:      newUserPool("pool", synced, "arn:aws:cognito-idp:us-west-2:111122223333:userpool/us-west-2_abc"),
+			expectedARN: "arn:aws:cognito-idp:us-west-2:111122223333:userpool/us-west-2_abc",
+		},
+		{
+			description:   "resource not synced",
+			object:        newUserPool("pool", nil, "arn:aws:cognito-idp:us-west-2:111122223333:userpool/us-west-2_abc"),
+			expectedError: "not synced",
+		},
+		{
+			description:   "terminal resource",
+			object:        newUserPool("pool", terminal, ""),
+			expectedError: "ACK.Terminal",
+		},
+		{
+			description:   "synced resource without ARN",
+			object:        newUserPool("pool", synced, ""),
+			expectedError: "Status.ACKResourceMetadata.ARN",
+		},
+	} {
+		t.Run(tt.description, func(t *testing.T) {
+			c := fake.NewClientBuilder().WithObjects(tt.object).Build()
+			arn, err := references.ResolveARN(context.Background(), c, references.CognitoUserPoolGVK, "pool", "default")
+			if tt.expectedError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedARN, *arn)
+		})
+	}
+}
+
+func TestNetworkLoadBalancerARNFromHostname(t *testing.T) {
+	for _, tt := range []struct {
+		description string
+		hostname    string
+
+		expectedARN   string
+		expectedError bool
+	}{
+		{
+			description: "load balancer provisioned by the AWS Load Balancer Controller",
+			hostname:    "k8s-default-echo-0123456789-0a1b2c3d4e5f6789.elb.us-west-2.amazonaws.com",
+			expectedARN: "arn:aws:elasticloadbalancing:us-west-2:111122223333:loadbalancer/net/k8s-default-echo-0123456789/0a1b2c3d4e5f6789",
+		},
+		{
+			description: "load balancer in the China partition",
+			hostname:    "internal-nlb-0a1b2c3d4e5f6789.elb.cn-north-1.amazonaws.com.cn",
+			expectedARN: "arn:aws-cn:elasticloadbalancing:cn-north-1:111122223333:loadbalancer/net/internal-nlb/0a1b2c3d4e5f6789",
+		},
+		{
+			description:   "classic load balancer hostname",
+			hostname:      "a1b2c3d4e5f6-1234567890.us-west-2.elb.amazonaws.com",
+			expectedError: true,
+		},
+		{
+			description:   "hostname without load balancer id",
+			hostname:      "nlb.elb.us-west-2.amazonaws.com",
+			expectedError: true,
+		},
+	} {
+		t.Run(tt.description, func(t *testing.T) {
+			arn, err := references.NetworkLoadBalancerARNFromHostname(tt.hostname, "111122223333")
+			if tt.expectedError {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedARN, arn)
+		})
+	}
+}
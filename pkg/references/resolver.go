@@ -0,0 +1,129 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package references
+
+import (
+	"context"
+	"sync"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	ackcfg "github.com/aws-controllers-k8s/runtime/pkg/config"
+	ackmetrics "github.com/aws-controllers-k8s/runtime/pkg/metrics"
+	acktypes "github.com/aws-controllers-k8s/runtime/pkg/types"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/go-logr/logr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Resolver resolves the references of a kind of resource that are not
+// declared in generator.yaml, e.g. references to Kubernetes Services, so that
+// they are kept when the resource packages are generated again.
+type Resolver interface {
+	// ResolveReferences returns a copy of res with the fields set from its
+	// references, and whether res has such references. rm is the generated
+	// resource manager of the kind of res.
+	ResolveReferences(
+		ctx context.Context,
+		apiReader client.Reader,
+		rm acktypes.AWSResourceManager,
+		res acktypes.AWSResource,
+	) (acktypes.AWSResource, bool, error)
+	// ClearResolvedReferences returns a copy of res without the fields set by
+	// ResolveReferences.
+	ClearResolvedReferences(res acktypes.AWSResource) acktypes.AWSResource
+}
+
+var (
+	resolversMu sync.Mutex
+	resolvers   = map[string]Resolver{}
+)
+
+// RegisterResolver registers the resolver of the references of the resources
+// of a kind, which the managers returned by WrapManagerFactories apply after
+// the generated references.
+func RegisterResolver(kind string, r Resolver) {
+	resolversMu.Lock()
+	defer resolversMu.Unlock()
+	resolvers[kind] = r
+}
+
+func resolverFor(kind string) Resolver {
+	resolversMu.Lock()
+	defer resolversMu.Unlock()
+	return resolvers[kind]
+}
+
+// WrapManagerFactories returns resource manager factories whose managers
+// also resolve the references of the registered resolvers. The factories must
+// be the generated ones, which the resolvers expect the managers of.
+func WrapManagerFactories(
+	factories []acktypes.AWSResourceManagerFactory,
+) []acktypes.AWSResourceManagerFactory {
+	wrapped := make([]acktypes.AWSResourceManagerFactory, 0, len(factories))
+	for _, f := range factories {
+		r := resolverFor(f.ResourceDescriptor().GroupVersionKind().Kind)
+		if r == nil {
+			wrapped = append(wrapped, f)
+			continue
+		}
+		wrapped = append(wrapped, &managerFactory{AWSResourceManagerFactory: f, resolver: r})
+	}
+	return wrapped
+}
+
+type managerFactory struct {
+	acktypes.AWSResourceManagerFactory
+	resolver Resolver
+}
+
+// ManagerFor returns a resource manager resolving the references of the
+// resolver after the generated ones.
+func (f *managerFactory) ManagerFor(
+	cfg ackcfg.Config,
+	clientcfg aws.Config,
+	log logr.Logger,
+	metrics *ackmetrics.Metrics,
+	rr acktypes.Reconciler,
+	id ackv1alpha1.AWSAccountID,
+	region ackv1alpha1.AWSRegion,
+	roleARN ackv1alpha1.AWSResourceName,
+) (acktypes.AWSResourceManager, error) {
+	rm, err := f.AWSResourceManagerFactory.ManagerFor(cfg, clientcfg, log, metrics, rr, id, region, roleARN)
+	if err != nil {
+		return nil, err
+	}
+	return &resourceManager{AWSResourceManager: rm, resolver: f.resolver}, nil
+}
+
+type resourceManager struct {
+	acktypes.AWSResourceManager
+	resolver Resolver
+}
+
+func (rm *resourceManager) ResolveReferences(
+	ctx context.Context,
+	apiReader client.Reader,
+	res acktypes.AWSResource,
+) (acktypes.AWSResource, bool, error) {
+	resolved, hasReferences, err := rm.AWSResourceManager.ResolveReferences(ctx, apiReader, res)
+	if err != nil {
+		return resolved, hasReferences, err
+	}
+	resolved, hasCustomReferences, err := rm.resolver.ResolveReferences(ctx, apiReader, rm.AWSResourceManager, resolved)
+	return resolved, hasReferences || hasCustomReferences, err
+}
+
+func (rm *resourceManager) ClearResolvedReferences(res acktypes.AWSResource) acktypes.AWSResource {
+	return rm.resolver.ClearResolvedReferences(rm.AWSResourceManager.ClearResolvedReferences(res))
+}
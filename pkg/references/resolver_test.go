@@ -0,0 +1,137 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package references_test
+
+import (
+	"context"
+	"testing"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	ackcfg "github.com/aws-controllers-k8s/runtime/pkg/config"
+	ackmetrics "github.com/aws-controllers-k8s/runtime/pkg/metrics"
+	acktypes "github.com/aws-controllers-k8s/runtime/pkg/types"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	svcapitypes "github.com/aws-controllers-k8s/apigateway-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/apigateway-controller/pkg/references"
+	svcresource "github.com/aws-controllers-k8s/apigateway-controller/pkg/resource"
+
+	_ "github.com/aws-controllers-k8s/apigateway-controller/pkg/resource/vpc_link"
+)
+
+func vpcLinkManager(t *testing.T) (acktypes.AWSResourceManager, acktypes.AWSResourceDescriptor) {
+	t.Helper()
+	var factory acktypes.AWSResourceManagerFactory
+	for _, f := range references.WrapManagerFactories(svcresource.GetManagerFactories()) {
+		if f.ResourceDescriptor().GroupVersionKind().Kind == "VPCLink" {
+			factory = f
+		}
+	}
+	require.NotNil(t, factory)
+
+	clientcfg := aws.Config{
+		Region:      "us-west-2",
+		Credentials: credentials.NewStaticCredentialsProvider("AKID", "SECRET", ""),
+	}
+	rm, err := factory.ManagerFor(ackcfg.Config{}, clientcfg, logr.Discard(), ackmetrics.NewMetrics("apigateway"),
+		nil, "111122223333", "us-west-2", "")
+	require.NoError(t, err)
+	return rm, factory.ResourceDescriptor()
+}
+
+func newLoadBalancer(name string, arn string) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{Object: map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"name":      name,
+			"namespace": "default",
+		},
+		"status": map[string]interface{}{
+			"conditions": []interface{}{map[string]interface{}{"type": "ACK.ResourceSynced", "status": "True"}},
+			"ackResourceMetadata": map[string]interface{}{
+				"arn": arn,
+			},
+		},
+	}}
+	obj.SetGroupVersionKind(references.ELBv2LoadBalancerGVK)
+	return obj
+}
+
+func vpcLink(targetARNs []*string, targetRefs []*svcapitypes.VPCLinkTargetReference) *svcapitypes.VPCLink {
+	return &svcapitypes.VPCLink{
+		ObjectMeta: metav1.ObjectMeta{Name: "link", Namespace: "default"},
+		Spec: svcapitypes.VPCLinkSpec{
+			Name:       aws.String("link"),
+			TargetARNs: targetARNs,
+			TargetRefs: targetRefs,
+		},
+	}
+}
+
+func TestWrapManagerFactories_ResolveReferences(t *testing.T) {
+	const lbARN = "arn:aws:elasticloadbalancing:us-west-2:111122223333:loadbalancer/net/lb/50dc6c495c0c9188"
+	rm, rd := vpcLinkManager(t)
+	c := fake.NewClientBuilder().WithObjects(newLoadBalancer("lb", lbARN)).Build()
+
+	desired := rd.ResourceFromRuntimeObject(vpcLink(nil, []*svcapitypes.VPCLinkTargetReference{{
+		LoadBalancerRef: &ackv1alpha1.AWSResourceReferenceWrapper{
+			From: &ackv1alpha1.AWSResourceReference{Name: aws.String("lb")},
+		},
+	}}))
+	resolved, hasReferences, err := rm.ResolveReferences(context.Background(), c, desired)
+	require.NoError(t, err)
+	assert.True(t, hasReferences)
+	assert.Equal(t, []*string{aws.String(lbARN)}, resolved.RuntimeObject().(*svcapitypes.VPCLink).Spec.TargetARNs)
+	assert.Nil(t, desired.RuntimeObject().(*svcapitypes.VPCLink).Spec.TargetARNs)
+
+	cleared := rm.ClearResolvedReferences(resolved)
+	assert.Nil(t, cleared.RuntimeObject().(*svcapitypes.VPCLink).Spec.TargetARNs)
+}
+
+func TestWrapManagerFactories_ResolveReferencesValidation(t *testing.T) {
+	rm, rd := vpcLinkManager(t)
+	c := fake.NewClientBuilder().Build()
+
+	for _, tt := range []struct {
+		description string
+		vpcLink     *svcapitypes.VPCLink
+
+		expectedError string
+	}{
+		{
+			description:   "neither targetARNs nor targetRefs",
+			vpcLink:       vpcLink(nil, nil),
+			expectedError: "TargetARNs",
+		},
+		{
+			description: "both targetARNs and targetRefs",
+			vpcLink: vpcLink([]*string{aws.String("arn")}, []*svcapitypes.VPCLinkTargetReference{{
+				ServiceRef: &svcapitypes.ServiceReference{Name: aws.String("svc")},
+			}}),
+			expectedError: "TargetRefs",
+		},
+	} {
+		t.Run(tt.description, func(t *testing.T) {
+			_, _, err := rm.ResolveReferences(context.Background(), c, rd.ResourceFromRuntimeObject(tt.vpcLink))
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.expectedError)
+		})
+	}
+}
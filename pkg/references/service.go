@@ -0,0 +1,141 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package references
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// AnnotationLoadBalancerARN can be set on a Service to provide the ARN of
+	// its network load balancer explicitly, e.g. when the load balancer is
+	// owned by a different account than the one derived by the controller.
+	AnnotationLoadBalancerARN = "apigateway.services.k8s.aws/load-balancer-arn"
+)
+
+// ELBv2LoadBalancerGVK identifies the LoadBalancer resource of the ACK
+// Elastic Load Balancing v2 controller.
+var ELBv2LoadBalancerGVK = ELBv2GroupVersion.WithKind("LoadBalancer")
+
+// ServiceLoadBalancer describes the network load balancer that fronts a
+// Kubernetes Service of type LoadBalancer.
+type ServiceLoadBalancer struct {
+	// ARN is the ARN of the network load balancer.
+	ARN string
+	// Hostname is the DNS name of the network load balancer as reported in
+	// the Service status.
+	Hostname string
+}
+
+// GetService returns the referenced Kubernetes Service.
+func GetService(
+	ctx context.Context,
+	apiReader client.Reader,
+	name string,
+	namespace string,
+) (*corev1.Service, error) {
+	svc := &corev1.Service{}
+	namespacedName := types.NamespacedName{
+		Namespace: namespace,
+		Name:      name,
+	}
+	if err := apiReader.Get(ctx, namespacedName, svc); err != nil {
+		return nil, err
+	}
+	return svc, nil
+}
+
+// ResolveServiceLoadBalancer reads the referenced Kubernetes Service and
+// returns the network load balancer provisioned for it by the AWS Load
+// Balancer Controller. The Service must be of type LoadBalancer and report
+// the load balancer hostname in its status, otherwise a
+// `ResourceReferenceNotSyncedFor` error is returned so the reconciliation is
+// retried once the load balancer is provisioned.
+func ResolveServiceLoadBalancer(
+	ctx context.Context,
+	apiReader client.Reader,
+	name string,
+	namespace string,
+	accountID string,
+) (*ServiceLoadBalancer, error) {
+	svc, err := GetService(ctx, apiReader, name, namespace)
+	if err != nil {
+		return nil, err
+	}
+	return ServiceLoadBalancerFor(svc, accountID)
+}
+
+// ServiceLoadBalancerFor returns the network load balancer provisioned for the
+// supplied Service. See ResolveServiceLoadBalancer.
+func ServiceLoadBalancerFor(svc *corev1.Service, accountID string) (*ServiceLoadBalancer, error) {
+	if svc.Spec.Type != corev1.ServiceTypeLoadBalancer {
+		return nil, fmt.Errorf("service %s/%s is of type %s, expected %s",
+			svc.Namespace, svc.Name, svc.Spec.Type, corev1.ServiceTypeLoadBalancer)
+	}
+	var hostname string
+	for _, ingress := range svc.Status.LoadBalancer.Ingress {
+		if ingress.Hostname != "" {
+			hostname = ingress.Hostname
+			break
+		}
+	}
+	if hostname == "" {
+		return nil, ackerr.ResourceReferenceNotSyncedFor("Service", svc.Namespace, svc.Name)
+	}
+	if lbARN := svc.Annotations[AnnotationLoadBalancerARN]; lbARN != "" {
+		return &ServiceLoadBalancer{ARN: lbARN, Hostname: hostname}, nil
+	}
+	lbARN, err := NetworkLoadBalancerARNFromHostname(hostname, accountID)
+	if err != nil {
+		return nil, fmt.Errorf("service %s/%s: %w", svc.Namespace, svc.Name, err)
+	}
+	return &ServiceLoadBalancer{ARN: lbARN, Hostname: hostname}, nil
+}
+
+// NetworkLoadBalancerARNFromHostname derives the ARN of a network load
+// balancer from its DNS name. Network load balancer DNS names have the form
+// {name}-{id}.elb.{region}.amazonaws.com, and the corresponding ARN is
+// arn:{partition}:elasticloadbalancing:{region}:{account}:loadbalancer/net/{name}/{id}.
+func NetworkLoadBalancerARNFromHostname(hostname string, accountID string) (string, error) {
+	labels := strings.Split(hostname, ".")
+	if len(labels) < 4 || labels[1] != "elb" {
+		return "", fmt.Errorf("hostname %q is not the DNS name of a network load balancer", hostname)
+	}
+	sep := strings.LastIndex(labels[0], "-")
+	if sep <= 0 || sep == len(labels[0])-1 {
+		return "", fmt.Errorf("hostname %q is not the DNS name of a network load balancer", hostname)
+	}
+	lbName, lbID := labels[0][:sep], labels[0][sep+1:]
+	region := labels[2]
+	partition, found := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), region)
+	if !found {
+		return "", fmt.Errorf("failed to find partition for region %q", region)
+	}
+	return arn.ARN{
+		Partition: partition.ID(),
+		Service:   "elasticloadbalancing",
+		Region:    region,
+		AccountID: accountID,
+		Resource:  fmt.Sprintf("loadbalancer/net/%s/%s", lbName, lbID),
+	}.String(), nil
+}
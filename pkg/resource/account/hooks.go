@@ -0,0 +1,38 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package account
+
+import (
+	svcsdk "github.com/aws/aws-sdk-go-v2/service/apigateway"
+
+	"github.com/aws-controllers-k8s/apigateway-controller/pkg/util"
+	"github.com/aws-controllers-k8s/apigateway-controller/pkg/util/patch"
+)
+
+// updateAccountInput sets the CloudWatch role ARN of the account. The account
+// settings always exist, so UpdateAccount is used both to create and to
+// update an Account; deleting an Account leaves the settings in place, since
+// Stages of the account may still be logging through the role.
+func updateAccountInput(desired *resource, input *svcsdk.UpdateAccountInput) {
+	var patchSet patch.Set
+	patchSet.Replace("/cloudwatchRoleArn", desired.ko.Spec.CloudWatchRoleARN)
+	input.PatchOperations = patchSet.GetPatchOperations()
+}
+
+// customPreCompare ignores the drift of the account settings at the spec
+// paths listed in the ignore-drift annotation, e.g. spec.cloudWatchRoleARN
+// when the CloudWatch role of the account and region is set by another tool.
+func customPreCompare(a, b *resource) {
+	util.IgnoreDrift(a.ko, b.ko)
+}
@@ -42,6 +42,7 @@ func newResourceDelta(
 		delta.Add("", a, b)
 		return delta
 	}
+	customPreCompare(a, b)
 
 	if ackcompare.HasNilDifference(a.ko.Spec.ContentHandling, b.ko.Spec.ContentHandling) {
 		delta.Add("Spec.ContentHandling", a.ko.Spec.ContentHandling, b.ko.Spec.ContentHandling)
@@ -208,6 +208,9 @@ func (rm *resourceManager) sdkCreate(
 	}
 
 	rm.setStatusDefaults(ko)
+	if err := rm.syncCORS(ctx, &resource{ko}); err != nil {
+		return &resource{ko}, err
+	}
 	return &resource{ko}, nil
 }
 
@@ -15,19 +15,43 @@ package api_key
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"reflect"
 	"strings"
+	"time"
 
 	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	svcsdk "github.com/aws/aws-sdk-go-v2/service/apigateway"
+	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/apigateway/types"
+	"github.com/aws/smithy-go"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	svcapitypes "github.com/aws-controllers-k8s/apigateway-controller/apis/v1alpha1"
 	"github.com/aws-controllers-k8s/apigateway-controller/pkg/tags"
+	"github.com/aws-controllers-k8s/apigateway-controller/pkg/util"
 	"github.com/aws-controllers-k8s/apigateway-controller/pkg/util/patch"
 )
 
-func updateApiKeyInput(desired *resource, input *svcsdk.UpdateApiKeyInput, delta *ackcompare.Delta) {
+// validateValue returns a terminal error if the value of the API key is both
+// read from spec.value and generated, into spec.generatedValueSecret or by
+// the rotations of spec.rotation.
+func validateValue(ko *svcapitypes.APIKey) error {
+	if ko.Spec.Value == nil {
+		return nil
+	}
+	if ko.Spec.GeneratedValueSecret != nil {
+		return ackerr.NewTerminalError(errors.New("spec.value and spec.generatedValueSecret are mutually exclusive"))
+	}
+	if ko.Spec.Rotation != nil {
+		return ackerr.NewTerminalError(errors.New("spec.rotation requires the value of the key to be generated, spec.value cannot be set"))
+	}
+	return nil
+}
+
+func updateApiKeyInput(desired, latest *resource, input *svcsdk.UpdateApiKeyInput, delta *ackcompare.Delta) {
 	desiredSpec := desired.ko.Spec
 	var patchSet patch.Set
 
@@ -45,8 +69,8 @@ func updateApiKeyInput(desired *resource, input *svcsdk.UpdateApiKeyInput, delta
 	}
 
 	// Handle StageKeys with add/remove operations
-	if delta.DifferentAt("Spec.StageKeys") && desiredSpec.StageKeys != nil {
-		updateStageKeyPatches(&patchSet, desiredSpec.StageKeys, desiredSpec.StageKeys)
+	if delta.DifferentAt("Spec.StageKeys") {
+		updateStageKeyPatches(&patchSet, latest.ko.Spec.StageKeys, desiredSpec.StageKeys)
 	}
 
 	input.PatchOperations = patchSet.GetPatchOperations()
@@ -122,11 +146,182 @@ func updateTags(
 func getStageKeysFromStrings(stageKeyStrings []string) []*svcapitypes.StageKey {
 	stageKeys := make([]*svcapitypes.StageKey, 0, len(stageKeyStrings))
 	for _, stageKeyStr := range stageKeyStrings {
-		parts := strings.Split(stageKeyStr, "/")
+		restAPIID, stageName, ok := strings.Cut(stageKeyStr, "/")
+		if !ok {
+			continue
+		}
 		stageKeys = append(stageKeys, &svcapitypes.StageKey{
-			RestAPIID: &parts[0],
-			StageName: &parts[1],
+			RestAPIID: aws.String(restAPIID),
+			StageName: aws.String(stageName),
 		})
 	}
 	return stageKeys
 }
+
+// customPreCompare ignores the drift of the API key at the spec paths listed
+// in the ignore-drift annotation, e.g. spec.enabled when keys are disabled
+// by an incident runbook, and stage keys that are the same regardless of
+// their order and references.
+func customPreCompare(a, b *resource) {
+	util.IgnoreDrift(a.ko, b.ko)
+	if equalStageKeys(a.ko.Spec.StageKeys, b.ko.Spec.StageKeys) {
+		b.ko.Spec.StageKeys = a.ko.Spec.StageKeys
+	}
+}
+
+// equalStageKeys returns true if a and b hold the same "restApiId/stageName"
+// stage keys.
+func equalStageKeys(a, b []*svcapitypes.StageKey) bool {
+	keys := func(stageKeys []*svcapitypes.StageKey) map[string]bool {
+		m := map[string]bool{}
+		for _, sk := range stageKeys {
+			if sk != nil {
+				m[aws.ToString(sk.RestAPIID)+"/"+aws.ToString(sk.StageName)] = true
+			}
+		}
+		return m
+	}
+	return reflect.DeepEqual(keys(a), keys(b))
+}
+
+// initRotation sets the rotation status of an API key with a spec.rotation
+// that has none.
+func initRotation(ko *svcapitypes.APIKey) {
+	util.InitAPIKeyRotation(ko, time.Now())
+}
+
+// compareRotation adds a difference at Spec.Rotation when the latest API key
+// is due for rotation, or its previous key for deletion, so that the key is
+// rotated by an update.
+func compareRotation(delta *ackcompare.Delta, a, b *resource) {
+	now := time.Now()
+	if util.APIKeyRotationDue(b.ko, now) || util.APIKeyPreviousExpired(b.ko, now) {
+		delta.Add("Spec.Rotation", a.ko.Spec.Rotation, b.ko.Spec.Rotation)
+	}
+}
+
+// rotateAPIKey deletes the previous key of the latest API key once its overlap
+// period has ended, and rotates the key when it is due. The new key replaces
+// the latest one in the status of desired, which keeps the rotation state as
+// each step completes, so that it is kept should a later step fail. The
+// previous key still pending deletion is deleted right away by a rotation.
+func (rm *resourceManager) rotateAPIKey(ctx context.Context, desired, latest *resource) error {
+	now := time.Now()
+	status := latest.ko.Status.RotationStatus.DeepCopy()
+	if status == nil {
+		status = &svcapitypes.APIKeyRotationStatus{}
+	}
+	desired.ko.Status.RotationStatus = status
+	rotate := util.APIKeyRotationDue(latest.ko, now)
+	if status.PreviousID != nil && (rotate || util.APIKeyPreviousExpired(latest.ko, now)) {
+		if err := rm.retireAPIKey(ctx, status.PreviousID); err != nil {
+			return err
+		}
+		status.PreviousID = nil
+		status.PreviousExpirationTime = nil
+	}
+	if rotate {
+		resp, err := rm.createRotatedAPIKey(ctx, latest)
+		if err != nil {
+			return err
+		}
+		status.PreviousID = latest.ko.Status.ID
+		status.PreviousExpirationTime = &metav1.Time{Time: now.Add(util.APIKeyRotationOverlap(latest.ko))}
+		status.LastRotationTime = &metav1.Time{Time: now}
+		status.LastRequest = nil
+		if v, ok := latest.ko.Annotations[util.AnnotationRotate]; ok {
+			status.LastRequest = aws.String(v)
+		}
+		desired.ko.Status.ID = aws.String(aws.ToString(resp.Id))
+		if resp.CreatedDate != nil {
+			desired.ko.Status.CreatedDate = &metav1.Time{Time: *resp.CreatedDate}
+		}
+		if resp.LastUpdatedDate != nil {
+			desired.ko.Status.LastUpdatedDate = &metav1.Time{Time: *resp.LastUpdatedDate}
+		}
+	}
+	return nil
+}
+
+// createRotatedAPIKey creates a key like the latest API key, with its name,
+// description, stage keys and tags, and adds it to the usage plans of the
+// latest key. The new key is deleted if it cannot be added to all of them, so
+// that the rotation starts over.
+func (rm *resourceManager) createRotatedAPIKey(ctx context.Context, latest *resource) (*svcsdk.CreateApiKeyOutput, error) {
+	spec := latest.ko.Spec
+	input := &svcsdk.CreateApiKeyInput{
+		Name:        spec.Name,
+		Description: spec.Description,
+		CustomerId:  spec.CustomerID,
+		Enabled:     aws.ToBool(spec.Enabled),
+		Tags:        aws.ToStringMap(spec.Tags),
+	}
+	for _, sk := range spec.StageKeys {
+		input.StageKeys = append(input.StageKeys, svcsdktypes.StageKey{RestApiId: sk.RestAPIID, StageName: sk.StageName})
+	}
+	resp, err := rm.sdkapi.CreateApiKey(ctx, input)
+	rm.metrics.RecordAPICall("CREATE", "CreateApiKey", err)
+	if err != nil {
+		return nil, err
+	}
+	id := aws.String(aws.ToString(resp.Id))
+
+	pages := svcsdk.NewGetUsagePlansPaginator(rm.sdkapi, &svcsdk.GetUsagePlansInput{KeyId: latest.ko.Status.ID})
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+		rm.metrics.RecordAPICall("READ_MANY", "GetUsagePlans", err)
+		if err == nil {
+			for _, plan := range page.Items {
+				_, err = rm.sdkapi.CreateUsagePlanKey(ctx, &svcsdk.CreateUsagePlanKeyInput{
+					UsagePlanId: plan.Id,
+					KeyId:       id,
+					KeyType:     aws.String("API_KEY"),
+				})
+				rm.metrics.RecordAPICall("CREATE", "CreateUsagePlanKey", err)
+				if err != nil {
+					break
+				}
+			}
+		}
+		if err != nil {
+			_, deleteErr := rm.sdkapi.DeleteApiKey(ctx, &svcsdk.DeleteApiKeyInput{ApiKey: id})
+			rm.metrics.RecordAPICall("DELETE", "DeleteApiKey", deleteErr)
+			return nil, err
+		}
+	}
+	return resp, nil
+}
+
+// deletePreviousAPIKey deletes the previous key of a rotated API key that is
+// still in its overlap period.
+func (rm *resourceManager) deletePreviousAPIKey(ctx context.Context, r *resource) error {
+	if r.ko.Status.RotationStatus == nil || r.ko.Status.RotationStatus.PreviousID == nil {
+		return nil
+	}
+	return rm.retireAPIKey(ctx, r.ko.Status.RotationStatus.PreviousID)
+}
+
+// retireAPIKey disables, then deletes, the previous key of a rotated API key.
+func (rm *resourceManager) retireAPIKey(ctx context.Context, id *string) error {
+	var patchSet patch.Set
+	patchSet.Replace("/enabled", aws.String("false"))
+	_, err := rm.sdkapi.UpdateApiKey(ctx, &svcsdk.UpdateApiKeyInput{
+		ApiKey:          id,
+		PatchOperations: patchSet.GetPatchOperations(),
+	})
+	rm.metrics.RecordAPICall("UPDATE", "UpdateApiKey", err)
+	if err != nil && !isNotFound(err) {
+		return err
+	}
+	_, err = rm.sdkapi.DeleteApiKey(ctx, &svcsdk.DeleteApiKeyInput{ApiKey: id})
+	rm.metrics.RecordAPICall("DELETE", "DeleteApiKey", err)
+	if err != nil && !isNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+func isNotFound(err error) bool {
+	var awsErr smithy.APIError
+	return errors.As(err, &awsErr) && awsErr.ErrorCode() == "NotFoundException"
+}
@@ -78,12 +78,6 @@ func (rm *resourceManager) sdkFind(
 	var resp *svcsdk.GetApiKeyOutput
 	resp, err = rm.sdkapi.GetApiKey(ctx, input)
 
-	if resp.StageKeys != nil {
-		r.ko.Spec.StageKeys = getStageKeysFromStrings(resp.StageKeys)
-	} else {
-		r.ko.Spec.StageKeys = nil
-	}
-
 	rm.metrics.RecordAPICall("READ_ONE", "GetApiKey", err)
 	if err != nil {
 		var awsErr smithy.APIError
@@ -135,6 +129,12 @@ func (rm *resourceManager) sdkFind(
 	}
 
 	rm.setStatusDefaults(ko)
+	if resp.StageKeys != nil {
+		ko.Spec.StageKeys = getStageKeysFromStrings(resp.StageKeys)
+	} else {
+		ko.Spec.StageKeys = nil
+	}
+	initRotation(ko)
 	return &resource{ko}, nil
 }
 
@@ -174,6 +174,9 @@ func (rm *resourceManager) sdkCreate(
 	defer func() {
 		exit(err)
 	}()
+	if err := validateValue(desired.ko); err != nil {
+		return nil, err
+	}
 	input, err := rm.newCreateRequestPayload(ctx, desired)
 	if err != nil {
 		return nil, err
@@ -183,12 +186,6 @@ func (rm *resourceManager) sdkCreate(
 	_ = resp
 	resp, err = rm.sdkapi.CreateApiKey(ctx, input)
 
-	if resp.StageKeys != nil {
-		desired.ko.Spec.StageKeys = getStageKeysFromStrings(resp.StageKeys)
-	} else {
-		desired.ko.Spec.StageKeys = nil
-	}
-
 	rm.metrics.RecordAPICall("CREATE", "CreateApiKey", err)
 	if err != nil {
 		return nil, err
@@ -304,6 +301,23 @@ func (rm *resourceManager) sdkUpdate(
 	defer func() {
 		exit(err)
 	}()
+	if err := validateValue(desired.ko); err != nil {
+		return nil, err
+	}
+
+	// Rotate the key before the other updates, which then apply to the new key
+	if delta.DifferentAt("Spec.Rotation") {
+		if err := rm.rotateAPIKey(ctx, desired, latest); err != nil {
+			return desired, err
+		}
+		// desired holds the new key and the rotation status, which are kept
+		// should the other updates fail.
+		defer func() {
+			if err != nil && updated == nil {
+				updated = desired
+			}
+		}()
+	}
 
 	// Handle tag updates separately through TagResource/UntagResource APIs
 	if delta.DifferentAt("Spec.Tags") {
@@ -312,7 +326,7 @@ func (rm *resourceManager) sdkUpdate(
 		}
 	}
 
-	if !delta.DifferentExcept("Spec.Tags") {
+	if !delta.DifferentExcept("Spec.Tags", "Spec.Rotation") {
 		return desired, nil
 	}
 
@@ -321,18 +335,12 @@ func (rm *resourceManager) sdkUpdate(
 		return nil, err
 	}
 
-	updateApiKeyInput(desired, input, delta)
+	updateApiKeyInput(desired, latest, input, delta)
 
 	var resp *svcsdk.UpdateApiKeyOutput
 	_ = resp
 	resp, err = rm.sdkapi.UpdateApiKey(ctx, input)
 
-	if resp.StageKeys != nil {
-		desired.ko.Spec.StageKeys = getStageKeysFromStrings(resp.StageKeys)
-	} else {
-		desired.ko.Spec.StageKeys = nil
-	}
-
 	rm.metrics.RecordAPICall("UPDATE", "UpdateApiKey", err)
 	if err != nil {
 		return nil, err
@@ -408,6 +416,10 @@ func (rm *resourceManager) sdkDelete(
 	defer func() {
 		exit(err)
 	}()
+	if err := rm.deletePreviousAPIKey(ctx, r); err != nil {
+		return nil, err
+	}
+
 	input, err := rm.newDeleteRequestPayload(r)
 	if err != nil {
 		return nil, err
@@ -0,0 +1,235 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package api_key_import
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	ackrequeue "github.com/aws-controllers-k8s/runtime/pkg/requeue"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	svcsdk "github.com/aws/aws-sdk-go-v2/service/apigateway"
+	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/apigateway/types"
+
+	svcapitypes "github.com/aws-controllers-k8s/apigateway-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/apigateway-controller/pkg/util"
+)
+
+// importRequeueAfter is the delay before the next batch of an import is
+// imported.
+const importRequeueAfter = 5 * time.Second
+
+// readImportCSV reads the CSV file of an import from its Secret.
+func (rm *resourceManager) readImportCSV(
+	ctx context.Context,
+	ko *svcapitypes.APIKeyImport,
+) (*util.APIKeyImportCSV, error) {
+	data, err := rm.rr.SecretValueFromReference(ctx, ko.Spec.CSV)
+	if err != nil {
+		return nil, err
+	}
+	return util.ParseAPIKeyImportCSV(data)
+}
+
+// setImportBatch sets the body of the ImportApiKeys call to the pending batch
+// of rows of the CSV file of an import, or to the first batch not imported
+// yet, and returns the batch.
+func (rm *resourceManager) setImportBatch(
+	ctx context.Context,
+	r *resource,
+	input *svcsdk.ImportApiKeysInput,
+) (*util.APIKeyImportBatch, error) {
+	c, err := rm.readImportCSV(ctx, r.ko)
+	if err != nil {
+		return nil, err
+	}
+	batch, err := util.NextAPIKeyImportBatch(c, r.ko)
+	if err != nil {
+		return nil, err
+	}
+	if batch == nil {
+		return nil, errors.New("the CSV file has no rows to import")
+	}
+	input.Format = svcsdktypes.ApiKeysFormatCsv
+	input.Body = batch.Body
+	return batch, nil
+}
+
+// createdKeys returns the API keys created since an import was created. They
+// are only read to reconcile a pending batch.
+func (rm *resourceManager) createdKeys(
+	ctx context.Context,
+	ko *svcapitypes.APIKeyImport,
+) ([]svcsdktypes.ApiKey, error) {
+	var keys []svcsdktypes.ApiKey
+	paginator := svcsdk.NewGetApiKeysPaginator(rm.sdkapi, &svcsdk.GetApiKeysInput{})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		rm.metrics.RecordAPICall("READ_MANY", "GetApiKeys", err)
+		if err != nil {
+			return nil, err
+		}
+		for _, key := range page.Items {
+			if !aws.ToTime(key.CreatedDate).Before(ko.CreationTimestamp.Time) {
+				keys = append(keys, key)
+			}
+		}
+	}
+	return keys, nil
+}
+
+// startImportBatch returns the import with its pending batch recorded when
+// an earlier ImportApiKeys call of the batch imported it but its result was
+// not recorded, because the call failed after importing it, the status update
+// failed or the controller stopped. Otherwise it records the batch as pending
+// in the status of r, which is kept when the call fails, and returns nil.
+func (rm *resourceManager) startImportBatch(
+	ctx context.Context,
+	r *resource,
+	batch *util.APIKeyImportBatch,
+) (*resource, error) {
+	if r.ko.Status.PendingBatch != nil {
+		keys, err := rm.createdKeys(ctx, r.ko)
+		if err != nil {
+			return nil, err
+		}
+		if ids, keyNames := util.PendingAPIKeyImportBatchIDs(r.ko, batch, keys); ids != nil {
+			ko := r.ko.DeepCopy()
+			rm.setStatusDefaults(ko)
+			ko.Status.IDs = append(ko.Status.IDs, aws.StringSlice(ids)...)
+			util.RecordAPIKeyImportBatch(ko, batch, ids, keyNames)
+			return &resource{ko}, nil
+		}
+	}
+	util.SetAPIKeyImportPendingBatch(r.ko, batch)
+	return nil, nil
+}
+
+// recordImportBatch records a batch imported by an ImportApiKeys call in the
+// status of an import. When rows of the batch were skipped, the names of the
+// imported keys are read to tell which; the batch is still recorded without
+// its skipped rows when they cannot be read.
+func (rm *resourceManager) recordImportBatch(
+	ctx context.Context,
+	ko *svcapitypes.APIKeyImport,
+	batch *util.APIKeyImportBatch,
+	resp *svcsdk.ImportApiKeysOutput,
+) error {
+	var keyNames map[string]string
+	var err error
+	if len(resp.Ids) < len(batch.Names) {
+		keyNames, err = rm.keyNames(ctx, resp.Ids)
+	}
+	util.RecordAPIKeyImportBatch(ko, batch, resp.Ids, keyNames)
+	return err
+}
+
+// keyNames returns the names of the API keys with the supplied identifiers,
+// by identifier.
+func (rm *resourceManager) keyNames(ctx context.Context, ids []string) (map[string]string, error) {
+	keyNames := map[string]string{}
+	for _, id := range ids {
+		resp, err := rm.sdkapi.GetApiKey(ctx, &svcsdk.GetApiKeyInput{ApiKey: aws.String(id)})
+		rm.metrics.RecordAPICall("READ_ONE", "GetApiKey", err)
+		if err != nil {
+			return nil, err
+		}
+		keyNames[id] = aws.ToString(resp.Name)
+	}
+	return keyNames, nil
+}
+
+// customFindAPIKeyImport returns the import with the number of rows of its CSV
+// file, which rows appended to the file increase, once its first batch was
+// imported. There is nothing to read from API Gateway: the imported keys are
+// not managed by the import.
+func (rm *resourceManager) customFindAPIKeyImport(
+	ctx context.Context,
+	r *resource,
+) (*resource, error) {
+	if r.ko.Status.ImportedRows == nil {
+		return nil, ackerr.NotFound
+	}
+	ko := r.ko.DeepCopy()
+	c, err := rm.readImportCSV(ctx, ko)
+	if err != nil {
+		// The Secret of a completed import may be deleted once its keys are
+		// imported.
+		if errors.Is(err, ackerr.SecretNotFound) &&
+			aws.ToString(ko.Status.ImportStatus) == util.APIKeyImportStatusCompleted {
+			return &resource{ko}, nil
+		}
+		return nil, err
+	}
+	util.SetAPIKeyImportProgress(ko, c.Rows())
+	rm.setStatusDefaults(ko)
+	return &resource{ko}, nil
+}
+
+// customUpdateAPIKeyImport imports the next batch of rows of the CSV file of
+// an import, and requeues the import while rows remain, so that each
+// reconciliation imports a single batch and records it.
+func (rm *resourceManager) customUpdateAPIKeyImport(
+	ctx context.Context,
+	desired *resource,
+	latest *resource,
+	delta *ackcompare.Delta,
+) (*resource, error) {
+	ko := latest.ko.DeepCopy()
+	rm.setStatusDefaults(ko)
+	input, err := rm.newCreateRequestPayload(ctx, &resource{ko})
+	if err != nil {
+		return nil, err
+	}
+	batch, err := rm.setImportBatch(ctx, &resource{ko}, input)
+	if err != nil {
+		return &resource{ko}, err
+	}
+	updated, err := rm.startImportBatch(ctx, &resource{ko}, batch)
+	if err != nil {
+		return &resource{ko}, err
+	}
+	if updated == nil {
+		resp, err := rm.sdkapi.ImportApiKeys(ctx, input)
+		rm.metrics.RecordAPICall("UPDATE", "ImportApiKeys", err)
+		if err != nil {
+			return &resource{ko}, err
+		}
+		ko.Status.IDs = append(ko.Status.IDs, aws.StringSlice(resp.Ids)...)
+		ko.Status.Warnings = append(ko.Status.Warnings, aws.StringSlice(resp.Warnings)...)
+		if err := rm.recordImportBatch(ctx, ko, batch, resp); err != nil {
+			return &resource{ko}, err
+		}
+		updated = &resource{ko}
+	}
+	if aws.ToString(updated.ko.Status.ImportStatus) == util.APIKeyImportStatusImporting {
+		return updated, ackrequeue.NeededAfter(nil, importRequeueAfter)
+	}
+	return updated, nil
+}
+
+// compareImport adds a difference to the delta while rows of the CSV file of
+// the latest import remain to be imported, so that an update imports them.
+func compareImport(
+	delta *ackcompare.Delta,
+	a *resource,
+	b *resource,
+) {
+	if aws.ToString(b.ko.Status.ImportStatus) == util.APIKeyImportStatusImporting {
+		delta.Add("Spec.CSV", a.ko.Spec.CSV, b.ko.Spec.CSV)
+	}
+}
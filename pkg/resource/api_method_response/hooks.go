@@ -14,6 +14,7 @@
 package api_method_response
 
 import (
+	"context"
 	"fmt"
 	"strconv"
 	"strings"
@@ -22,6 +23,8 @@ import (
 	svcsdk "github.com/aws/aws-sdk-go-v2/service/apigateway"
 	"github.com/aws/aws-sdk-go/aws"
 
+	"github.com/aws-controllers-k8s/apigateway-controller/pkg/cors"
+	"github.com/aws-controllers-k8s/apigateway-controller/pkg/util"
 	"github.com/aws-controllers-k8s/apigateway-controller/pkg/util/patch"
 )
 
@@ -60,3 +63,23 @@ func updateMethodResponseInput(desired, latest *resource, input *svcsdk.UpdateMe
 
 	input.PatchOperations = patchSet.GetPatchOperations()
 }
+
+// customPreCompare ignores the CORS headers that the CORS configuration of the
+// Resource adds to the response, unless they are also set on the method response.
+func customPreCompare(a, b *resource) {
+	util.IgnoreDrift(a.ko, b.ko)
+	for _, header := range util.CORSResponseHeaders {
+		parameter := util.ResponseHeaderParameter(header)
+		if _, ok := a.ko.Spec.ResponseParameters[parameter]; !ok {
+			delete(b.ko.Spec.ResponseParameters, parameter)
+		}
+	}
+}
+
+// syncCORS updates the CORS preflight that the Resource of the method
+// maintains from its spec.cors, if any, once the method response was put,
+// see cors.SyncMethod.
+func (rm *resourceManager) syncCORS(ctx context.Context, r *resource) error {
+	return cors.SyncMethod(ctx, rm.sdkapi, rm.metrics, r.ko.Spec.RestAPIID, r.ko.Spec.ResourceID,
+		aws.StringValue(r.ko.Spec.HTTPMethod), false)
+}
@@ -42,6 +42,7 @@ func newResourceDelta(
 		delta.Add("", a, b)
 		return delta
 	}
+	customPreCompare(a, b)
 
 	if ackcompare.HasNilDifference(a.ko.Spec.AuthType, b.ko.Spec.AuthType) {
 		delta.Add("Spec.AuthType", a.ko.Spec.AuthType, b.ko.Spec.AuthType)
@@ -99,6 +100,9 @@ func newResourceDelta(
 			delta.Add("Spec.ProviderARNs", a.ko.Spec.ProviderARNs, b.ko.Spec.ProviderARNs)
 		}
 	}
+	if !reflect.DeepEqual(a.ko.Spec.ProviderRefs, b.ko.Spec.ProviderRefs) {
+		delta.Add("Spec.ProviderRefs", a.ko.Spec.ProviderRefs, b.ko.Spec.ProviderRefs)
+	}
 	if ackcompare.HasNilDifference(a.ko.Spec.RestAPIID, b.ko.Spec.RestAPIID) {
 		delta.Add("Spec.RestAPIID", a.ko.Spec.RestAPIID, b.ko.Spec.RestAPIID)
 	} else if a.ko.Spec.RestAPIID != nil && b.ko.Spec.RestAPIID != nil {
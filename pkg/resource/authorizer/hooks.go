@@ -14,15 +14,31 @@
 package authorizer
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"strconv"
 
 	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	acktypes "github.com/aws-controllers-k8s/runtime/pkg/types"
 	svcsdk "github.com/aws/aws-sdk-go-v2/service/apigateway"
 	"github.com/aws/aws-sdk-go/aws"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	svcapitypes "github.com/aws-controllers-k8s/apigateway-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/apigateway-controller/pkg/references"
+	"github.com/aws-controllers-k8s/apigateway-controller/pkg/util"
 	"github.com/aws-controllers-k8s/apigateway-controller/pkg/util/patch"
 )
 
+// +kubebuilder:rbac:groups=cognitoidentityprovider.services.k8s.aws,resources=userpools,verbs=get;list
+// +kubebuilder:rbac:groups=cognitoidentityprovider.services.k8s.aws,resources=userpools/status,verbs=get;list
+
+func init() {
+	references.RegisterResolver(GroupKind.Kind, providerRefsResolver{})
+}
+
 // updateAuthorizerInput patches the resource based on the delta between desired and latest state.
 // It uses the patchSet utility to generate add/remove operations for list fields like providerARNs,
 // as indicated by the UpdateAuthorizer API documentation.
@@ -112,3 +128,90 @@ func updateProviderARNsPatches(
 		}
 	}
 }
+
+// validateAuthorizerSpec checks the fields required by the authorizer type
+// before calling CreateAuthorizer or UpdateAuthorizer. COGNITO_USER_POOLS
+// authorizers must list at least one user pool, either through ProviderARNs
+// or ProviderRefs, and cannot have an AuthorizerURI.
+func validateAuthorizerSpec(r *resource) error {
+	spec := r.ko.Spec
+	if spec.Type == nil || *spec.Type != string(svcapitypes.AuthorizerType_COGNITO_USER_POOLS) {
+		return nil
+	}
+	if len(spec.ProviderARNs) == 0 {
+		return errors.New("spec.providerARNs or spec.providerRefs must contain at least one user pool for COGNITO_USER_POOLS authorizers")
+	}
+	if spec.AuthorizerURI != nil && *spec.AuthorizerURI != "" {
+		return errors.New("spec.authorizerURI must not be set for COGNITO_USER_POOLS authorizers")
+	}
+	return nil
+}
+
+// customPreCompare ignores the drift of the authorizer at the spec paths
+// listed in the ignore-drift annotation, e.g.
+// spec.authorizerResultTTLInSeconds when the cache TTL is tuned in the
+// console.
+func customPreCompare(a, b *resource) {
+	util.IgnoreDrift(a.ko, b.ko)
+}
+
+// providerRefsResolver resolves spec.providerRefs, which point at ACK Cognito
+// UserPools, into spec.providerARNs.
+type providerRefsResolver struct{}
+
+func (providerRefsResolver) ResolveReferences(
+	ctx context.Context,
+	apiReader client.Reader,
+	rm acktypes.AWSResourceManager,
+	res acktypes.AWSResource,
+) (acktypes.AWSResource, bool, error) {
+	ko := rm.(*resourceManager).concreteResource(res).ko.DeepCopy()
+	if len(ko.Spec.ProviderRefs) > 0 && len(ko.Spec.ProviderARNs) > 0 {
+		return &resource{ko}, true, ackerr.ResourceReferenceAndIDNotSupportedFor("ProviderARNs", "ProviderRefs")
+	}
+	hasReferences, err := resolveReferenceForProviderARNs(ctx, apiReader, ko)
+	return &resource{ko}, hasReferences, err
+}
+
+func (providerRefsResolver) ClearResolvedReferences(res acktypes.AWSResource) acktypes.AWSResource {
+	ko := res.(*resource).ko.DeepCopy()
+	if len(ko.Spec.ProviderRefs) > 0 {
+		ko.Spec.ProviderARNs = nil
+	}
+	return &resource{ko}
+}
+
+// resolveReferenceForProviderARNs reads the Cognito UserPool resources
+// referenced from ProviderRefs field and sets the ProviderARNs from the
+// referenced resources. Returns a boolean indicating whether a reference
+// contains references, or an error
+func resolveReferenceForProviderARNs(
+	ctx context.Context,
+	apiReader client.Reader,
+	ko *svcapitypes.Authorizer,
+) (hasReferences bool, err error) {
+	var providerARNs []*string
+	for _, f0iter := range ko.Spec.ProviderRefs {
+		if f0iter == nil || f0iter.From == nil {
+			continue
+		}
+		hasReferences = true
+		arr := f0iter.From
+		if arr.Name == nil || *arr.Name == "" {
+			return hasReferences, fmt.Errorf("provided resource reference is nil or empty: ProviderRefs")
+		}
+		namespace := ko.ObjectMeta.GetNamespace()
+		if arr.Namespace != nil && *arr.Namespace != "" {
+			namespace = *arr.Namespace
+		}
+		userPoolARN, err := references.ResolveARN(ctx, apiReader, references.CognitoUserPoolGVK, *arr.Name, namespace)
+		if err != nil {
+			return hasReferences, err
+		}
+		providerARNs = append(providerARNs, userPoolARN)
+	}
+	if hasReferences {
+		ko.Spec.ProviderARNs = providerARNs
+	}
+	return hasReferences, nil
+}
@@ -186,6 +186,10 @@ func (rm *resourceManager) sdkCreate(
 	defer func() {
 		exit(err)
 	}()
+	if err := validateAuthorizerSpec(desired); err != nil {
+		return nil, ackerr.NewTerminalError(err)
+	}
+
 	input, err := rm.newCreateRequestPayload(ctx, desired)
 	if err != nil {
 		return nil, err
@@ -318,6 +322,10 @@ func (rm *resourceManager) sdkUpdate(
 	defer func() {
 		exit(err)
 	}()
+	if err := validateAuthorizerSpec(desired); err != nil {
+		return nil, ackerr.NewTerminalError(err)
+	}
+
 	input, err := rm.newUpdateRequestPayload(ctx, desired, delta)
 	if err != nil {
 		return nil, err
@@ -42,6 +42,7 @@ func newResourceDelta(
 		delta.Add("", a, b)
 		return delta
 	}
+	customPreCompare(a, b)
 
 	if ackcompare.HasNilDifference(a.ko.Spec.CacheClusterEnabled, b.ko.Spec.CacheClusterEnabled) {
 		delta.Add("Spec.CacheClusterEnabled", a.ko.Spec.CacheClusterEnabled, b.ko.Spec.CacheClusterEnabled)
@@ -17,6 +17,7 @@ import (
 	"github.com/aws-controllers-k8s/runtime/pkg/compare"
 	svcsdk "github.com/aws/aws-sdk-go-v2/service/apigateway"
 
+	"github.com/aws-controllers-k8s/apigateway-controller/pkg/util"
 	"github.com/aws-controllers-k8s/apigateway-controller/pkg/util/patch"
 )
 
@@ -30,3 +31,10 @@ func updateDeploymentInput(desired *resource, input *svcsdk.UpdateDeploymentInpu
 
 	input.PatchOperations = patchSet.GetPatchOperations()
 }
+
+// customPreCompare ignores the drift of the deployment at the spec paths
+// listed in the ignore-drift annotation, e.g. spec.description, the only
+// field that API Gateway lets be edited once a deployment is created.
+func customPreCompare(a, b *resource) {
+	util.IgnoreDrift(a.ko, b.ko)
+}
@@ -0,0 +1,55 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package documentation_part
+
+import (
+	"github.com/aws-controllers-k8s/runtime/pkg/compare"
+	svcsdk "github.com/aws/aws-sdk-go-v2/service/apigateway"
+
+	"github.com/aws-controllers-k8s/apigateway-controller/pkg/util"
+	"github.com/aws-controllers-k8s/apigateway-controller/pkg/util/patch"
+)
+
+func updateDocumentationPartInput(desired *resource, input *svcsdk.UpdateDocumentationPartInput, delta *compare.Delta) {
+	var patchSet patch.Set
+	if delta.DifferentAt("Spec.Properties") {
+		patchSet.Replace("/properties", desired.ko.Spec.Properties)
+	}
+	input.PatchOperations = patchSet.GetPatchOperations()
+}
+
+// customPreCompare ignores the location fields that API Gateway fills in with
+// defaults when they are not set, and differences in the formatting of the
+// JSON properties.
+func customPreCompare(a, b *resource) {
+	util.IgnoreDrift(a.ko, b.ko)
+	if a.ko.Spec.Location != nil && b.ko.Spec.Location != nil {
+		if a.ko.Spec.Location.Method == nil {
+			b.ko.Spec.Location.Method = nil
+		}
+		if a.ko.Spec.Location.Name == nil {
+			b.ko.Spec.Location.Name = nil
+		}
+		if a.ko.Spec.Location.Path == nil {
+			b.ko.Spec.Location.Path = nil
+		}
+		if a.ko.Spec.Location.StatusCode == nil {
+			b.ko.Spec.Location.StatusCode = nil
+		}
+	}
+	if a.ko.Spec.Properties != nil && b.ko.Spec.Properties != nil &&
+		util.JSONEqual(*a.ko.Spec.Properties, *b.ko.Spec.Properties) {
+		b.ko.Spec.Properties = a.ko.Spec.Properties
+	}
+}
@@ -0,0 +1,37 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package documentation_version
+
+import (
+	"github.com/aws-controllers-k8s/runtime/pkg/compare"
+	svcsdk "github.com/aws/aws-sdk-go-v2/service/apigateway"
+
+	"github.com/aws-controllers-k8s/apigateway-controller/pkg/util"
+	"github.com/aws-controllers-k8s/apigateway-controller/pkg/util/patch"
+)
+
+func updateDocumentationVersionInput(desired *resource, input *svcsdk.UpdateDocumentationVersionInput, delta *compare.Delta) {
+	var patchSet patch.Set
+	if delta.DifferentAt("Spec.Description") {
+		patchSet.Replace("/description", desired.ko.Spec.Description)
+	}
+	input.PatchOperations = patchSet.GetPatchOperations()
+}
+
+// customPreCompare ignores the drift of the documentation snapshot at the
+// spec paths listed in the ignore-drift annotation, e.g. spec.description
+// when release notes are edited in the console.
+func customPreCompare(a, b *resource) {
+	util.IgnoreDrift(a.ko, b.ko)
+}
@@ -0,0 +1,50 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package gateway_response
+
+import (
+	"github.com/aws-controllers-k8s/runtime/pkg/compare"
+	svcsdk "github.com/aws/aws-sdk-go-v2/service/apigateway"
+
+	"github.com/aws-controllers-k8s/apigateway-controller/pkg/util"
+	"github.com/aws-controllers-k8s/apigateway-controller/pkg/util/patch"
+)
+
+func updateGatewayResponseInput(desired, latest *resource, input *svcsdk.UpdateGatewayResponseInput, delta *compare.Delta) {
+	latestSpec := latest.ko.Spec
+	desiredSpec := desired.ko.Spec
+
+	var patchSet patch.Set
+	if delta.DifferentAt("Spec.StatusCode") {
+		patchSet.Replace("/statusCode", desiredSpec.StatusCode)
+	}
+	if delta.DifferentAt("Spec.ResponseParameters") {
+		patchSet.ForMap("/responseParameters", latestSpec.ResponseParameters, desiredSpec.ResponseParameters, true)
+	}
+	if delta.DifferentAt("Spec.ResponseTemplates") {
+		patchSet.ForMap("/responseTemplates", latestSpec.ResponseTemplates, desiredSpec.ResponseTemplates, true)
+	}
+
+	input.PatchOperations = patchSet.GetPatchOperations()
+}
+
+// customPreCompare ignores the status code of the gateway response when it is
+// not set, since API Gateway then uses the default status code of the
+// response type.
+func customPreCompare(a, b *resource) {
+	util.IgnoreDrift(a.ko, b.ko)
+	if a.ko.Spec.StatusCode == nil {
+		b.ko.Spec.StatusCode = nil
+	}
+}
@@ -110,6 +110,13 @@ func newResourceDelta(
 			delta.Add("Spec.PassthroughBehavior", a.ko.Spec.PassthroughBehavior, b.ko.Spec.PassthroughBehavior)
 		}
 	}
+	if ackcompare.HasNilDifference(a.ko.Spec.ReplacementPolicy, b.ko.Spec.ReplacementPolicy) {
+		delta.Add("Spec.ReplacementPolicy", a.ko.Spec.ReplacementPolicy, b.ko.Spec.ReplacementPolicy)
+	} else if a.ko.Spec.ReplacementPolicy != nil && b.ko.Spec.ReplacementPolicy != nil {
+		if *a.ko.Spec.ReplacementPolicy != *b.ko.Spec.ReplacementPolicy {
+			delta.Add("Spec.ReplacementPolicy", a.ko.Spec.ReplacementPolicy, b.ko.Spec.ReplacementPolicy)
+		}
+	}
 	if len(a.ko.Spec.RequestParameters) != len(b.ko.Spec.RequestParameters) {
 		delta.Add("Spec.RequestParameters", a.ko.Spec.RequestParameters, b.ko.Spec.RequestParameters)
 	} else if len(a.ko.Spec.RequestParameters) > 0 {
@@ -144,6 +151,9 @@ func newResourceDelta(
 	if !reflect.DeepEqual(a.ko.Spec.RestAPIRef, b.ko.Spec.RestAPIRef) {
 		delta.Add("Spec.RestAPIRef", a.ko.Spec.RestAPIRef, b.ko.Spec.RestAPIRef)
 	}
+	if !reflect.DeepEqual(a.ko.Spec.ServiceRef, b.ko.Spec.ServiceRef) {
+		delta.Add("Spec.ServiceRef", a.ko.Spec.ServiceRef, b.ko.Spec.ServiceRef)
+	}
 	if ackcompare.HasNilDifference(a.ko.Spec.TimeoutInMillis, b.ko.Spec.TimeoutInMillis) {
 		delta.Add("Spec.TimeoutInMillis", a.ko.Spec.TimeoutInMillis, b.ko.Spec.TimeoutInMillis)
 	} else if a.ko.Spec.TimeoutInMillis != nil && b.ko.Spec.TimeoutInMillis != nil {
@@ -14,16 +14,36 @@
 package integration
 
 import (
+	"context"
+	"fmt"
+	"slices"
 	"strconv"
 
 	"github.com/aws-controllers-k8s/runtime/pkg/compare"
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	acktypes "github.com/aws-controllers-k8s/runtime/pkg/types"
 	svcsdk "github.com/aws/aws-sdk-go-v2/service/apigateway"
+	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/apigateway/types"
 	"github.com/aws/aws-sdk-go/aws"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	svcapitypes "github.com/aws-controllers-k8s/apigateway-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/apigateway-controller/pkg/references"
+	"github.com/aws-controllers-k8s/apigateway-controller/pkg/util"
 	"github.com/aws-controllers-k8s/apigateway-controller/pkg/util/patch"
 )
 
+// +kubebuilder:rbac:groups="",resources=services,verbs=get;list;watch
+
+func init() {
+	references.RegisterResolver(GroupKind.Kind, serviceRefResolver{})
+}
+
+// immutableFieldPaths are the fields of an integration that API Gateway cannot
+// update in place.
+var immutableFieldPaths = []string{"Spec.Type"}
+
 func updateIntegrationInput(desired, latest *resource, input *svcsdk.UpdateIntegrationInput, delta *compare.Delta) {
 	latestSpec := latest.ko.Spec
 	desiredSpec := desired.ko.Spec
@@ -78,6 +98,7 @@ func updateIntegrationInput(desired, latest *resource, input *svcsdk.UpdateInteg
 }
 
 func customPreCompare(a, b *resource) {
+	util.IgnoreDrift(a.ko, b.ko)
 	if a.ko.Spec.RequestTemplates == nil && b.ko.Spec.RequestTemplates != nil {
 		a.ko.Spec.RequestTemplates = map[string]*string{}
 	} else if a.ko.Spec.RequestTemplates != nil && b.ko.Spec.RequestTemplates == nil {
@@ -94,3 +115,171 @@ func customPreCompare(a, b *resource) {
 		b.ko.Spec.TLSConfig = &svcapitypes.TLSConfig{}
 	}
 }
+
+// requiresReplacement returns true if any field that cannot be updated in place
+// differs between the desired and latest state.
+func requiresReplacement(delta *compare.Delta) bool {
+	return len(util.ChangedFields(delta, immutableFieldPaths...)) > 0
+}
+
+// replaceIntegration puts the integration again with the desired type, or
+// returns a terminal error if spec.replacementPolicy does not allow recreating
+// the integration. PutIntegration replaces the existing integration of the
+// method in a single call, so the existing integration stays in place if it
+// fails. The integration responses are read before and put back on the new
+// integration, so the APIIntegrationResponse resources managing them keep
+// observing the same identifiers.
+func (rm *resourceManager) replaceIntegration(
+	ctx context.Context,
+	desired *resource,
+	latest *resource,
+	delta *compare.Delta,
+) (*resource, error) {
+	if !util.RecreateRequested(desired.ko.Spec.ReplacementPolicy) {
+		return nil, util.ImmutableFieldsChangedError(util.ChangedFields(delta, immutableFieldPaths...))
+	}
+	resp, err := rm.sdkapi.GetIntegration(ctx, &svcsdk.GetIntegrationInput{
+		RestApiId:  latest.ko.Spec.RestAPIID,
+		ResourceId: latest.ko.Spec.ResourceID,
+		HttpMethod: latest.ko.Spec.HTTPMethod,
+	})
+	rm.metrics.RecordAPICall("READ_ONE", "GetIntegration", err)
+	if err != nil {
+		return nil, err
+	}
+	created, err := rm.sdkCreate(ctx, desired)
+	if err != nil {
+		return nil, err
+	}
+	for _, integrationResponse := range resp.IntegrationResponses {
+		_, err := rm.sdkapi.PutIntegrationResponse(ctx, &svcsdk.PutIntegrationResponseInput{
+			RestApiId:          desired.ko.Spec.RestAPIID,
+			ResourceId:         desired.ko.Spec.ResourceID,
+			HttpMethod:         desired.ko.Spec.HTTPMethod,
+			StatusCode:         integrationResponse.StatusCode,
+			ContentHandling:    integrationResponse.ContentHandling,
+			ResponseParameters: integrationResponse.ResponseParameters,
+			ResponseTemplates:  integrationResponse.ResponseTemplates,
+			SelectionPattern:   integrationResponse.SelectionPattern,
+		})
+		rm.metrics.RecordAPICall("CREATE", "PutIntegrationResponse", err)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return created, nil
+}
+
+// serviceIntegrationURI returns the URI of an integration with the supplied
+// Service port and path, reached through the network load balancer of the
+// Service.
+func serviceIntegrationURI(
+	svc *corev1.Service,
+	lb *references.ServiceLoadBalancer,
+	ref *svcapitypes.IntegrationServiceReference,
+) (string, error) {
+	port := *ref.Port
+	if !slices.ContainsFunc(svc.Spec.Ports, func(p corev1.ServicePort) bool {
+		return int64(p.Port) == port
+	}) {
+		return "", fmt.Errorf("service %s/%s has no port %d", svc.Namespace, svc.Name, port)
+	}
+	scheme := "http"
+	if ref.Scheme != nil {
+		scheme = *ref.Scheme
+	}
+	path := "/"
+	if ref.Path != nil {
+		path = *ref.Path
+	}
+	return fmt.Sprintf("%s://%s:%d%s", scheme, lb.Hostname, port, path), nil
+}
+
+// serviceRefResolver resolves spec.serviceRef, which points at a Kubernetes
+// Service, into spec.uri and spec.connectionType. spec.connectionID is
+// resolved from spec.connectionRef, which the VPCLink targeting the Service
+// must be referenced from.
+type serviceRefResolver struct{}
+
+func (serviceRefResolver) ResolveReferences(
+	ctx context.Context,
+	apiReader client.Reader,
+	rm acktypes.AWSResourceManager,
+	res acktypes.AWSResource,
+) (acktypes.AWSResource, bool, error) {
+	r := rm.(*resourceManager)
+	ko := r.concreteResource(res).ko.DeepCopy()
+	if err := validateServiceRef(ko); err != nil {
+		return &resource{ko}, ko.Spec.ServiceRef != nil, err
+	}
+	hasReferences, err := r.resolveReferenceForServiceRef(ctx, apiReader, ko)
+	return &resource{ko}, hasReferences, err
+}
+
+func (serviceRefResolver) ClearResolvedReferences(res acktypes.AWSResource) acktypes.AWSResource {
+	ko := res.(*resource).ko.DeepCopy()
+	if ko.Spec.ServiceRef != nil {
+		ko.Spec.ConnectionType = nil
+		ko.Spec.URI = nil
+	}
+	return &resource{ko}
+}
+
+// validateServiceRef validates that spec.serviceRef is only set on HTTP and
+// HTTP_PROXY integrations, along with the spec.connectionRef of the VPC link
+// to the Service and without the fields it sets.
+func validateServiceRef(ko *svcapitypes.Integration) error {
+	if ko.Spec.ServiceRef == nil {
+		return nil
+	}
+	if ko.Spec.URI != nil {
+		return ackerr.ResourceReferenceAndIDNotSupportedFor("URI", "ServiceRef")
+	}
+	if ko.Spec.ConnectionRef == nil {
+		return ackerr.NewTerminalError(fmt.Errorf("spec.serviceRef requires spec.connectionRef to reference the VPCLink targeting the Service"))
+	}
+	switch aws.StringValue(ko.Spec.Type) {
+	case string(svcsdktypes.IntegrationTypeHttp), string(svcsdktypes.IntegrationTypeHttpProxy):
+		return nil
+	}
+	return ackerr.NewTerminalError(fmt.Errorf("spec.serviceRef is only supported for HTTP and HTTP_PROXY integrations"))
+}
+
+// resolveReferenceForServiceRef reads the Kubernetes Service referenced from
+// ServiceRef field and sets the URI to the DNS name of its network load
+// balancer and the ConnectionType to VPC_LINK. Returns a boolean indicating
+// whether a reference contains references, or an error
+func (rm *resourceManager) resolveReferenceForServiceRef(
+	ctx context.Context,
+	apiReader client.Reader,
+	ko *svcapitypes.Integration,
+) (hasReferences bool, err error) {
+	if ko.Spec.ServiceRef == nil {
+		return false, nil
+	}
+	hasReferences = true
+	ref := ko.Spec.ServiceRef
+	if ref.Name == nil || *ref.Name == "" {
+		return hasReferences, fmt.Errorf("provided resource reference is nil or empty: ServiceRef")
+	}
+	namespace := ko.ObjectMeta.GetNamespace()
+	if ref.Namespace != nil && *ref.Namespace != "" {
+		namespace = *ref.Namespace
+	}
+	svc, err := references.GetService(ctx, apiReader, *ref.Name, namespace)
+	if err != nil {
+		return hasReferences, err
+	}
+	lb, err := references.ServiceLoadBalancerFor(svc, string(rm.awsAccountID))
+	if err != nil {
+		return hasReferences, err
+	}
+	uri, err := serviceIntegrationURI(svc, lb, ref)
+	if err != nil {
+		return hasReferences, ackerr.NewTerminalError(err)
+	}
+	ko.Spec.URI = &uri
+	ko.Spec.ConnectionType = aws.String(string(svcsdktypes.ConnectionTypeVpcLink))
+
+	return hasReferences, nil
+}
@@ -432,6 +432,9 @@ func (rm *resourceManager) sdkUpdate(
 	defer func() {
 		exit(err)
 	}()
+	if requiresReplacement(delta) {
+		return rm.replaceIntegration(ctx, desired, latest, delta)
+	}
 	input, err := rm.newUpdateRequestPayload(ctx, desired, delta)
 	if err != nil {
 		return nil, err
@@ -720,7 +723,6 @@ func (rm *resourceManager) terminalAWSError(err error) bool {
 	}
 	switch terminalErr.ErrorCode() {
 	case "BadRequestException",
-		"ConflictException",
 		"NotFoundException",
 		"InvalidParameter":
 		return true
@@ -42,6 +42,7 @@ func newResourceDelta(
 		delta.Add("", a, b)
 		return delta
 	}
+	customPreCompare(a, b)
 
 	if ackcompare.HasNilDifference(a.ko.Spec.APIKeyRequired, b.ko.Spec.APIKeyRequired) {
 		delta.Add("Spec.APIKeyRequired", a.ko.Spec.APIKeyRequired, b.ko.Spec.APIKeyRequired)
@@ -71,6 +72,9 @@ func newResourceDelta(
 			delta.Add("Spec.AuthorizerID", a.ko.Spec.AuthorizerID, b.ko.Spec.AuthorizerID)
 		}
 	}
+	if !reflect.DeepEqual(a.ko.Spec.AuthorizerRef, b.ko.Spec.AuthorizerRef) {
+		delta.Add("Spec.AuthorizerRef", a.ko.Spec.AuthorizerRef, b.ko.Spec.AuthorizerRef)
+	}
 	if ackcompare.HasNilDifference(a.ko.Spec.HTTPMethod, b.ko.Spec.HTTPMethod) {
 		delta.Add("Spec.HTTPMethod", a.ko.Spec.HTTPMethod, b.ko.Spec.HTTPMethod)
 	} else if a.ko.Spec.HTTPMethod != nil && b.ko.Spec.HTTPMethod != nil {
@@ -106,6 +110,9 @@ func newResourceDelta(
 			delta.Add("Spec.RequestValidatorID", a.ko.Spec.RequestValidatorID, b.ko.Spec.RequestValidatorID)
 		}
 	}
+	if !reflect.DeepEqual(a.ko.Spec.RequestValidatorRef, b.ko.Spec.RequestValidatorRef) {
+		delta.Add("Spec.RequestValidatorRef", a.ko.Spec.RequestValidatorRef, b.ko.Spec.RequestValidatorRef)
+	}
 	if ackcompare.HasNilDifference(a.ko.Spec.ResourceID, b.ko.Spec.ResourceID) {
 		delta.Add("Spec.ResourceID", a.ko.Spec.ResourceID, b.ko.Spec.ResourceID)
 	} else if a.ko.Spec.ResourceID != nil && b.ko.Spec.ResourceID != nil {
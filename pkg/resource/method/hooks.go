@@ -14,12 +14,15 @@
 package method
 
 import (
+	"context"
 	"strconv"
 
 	"github.com/aws-controllers-k8s/runtime/pkg/compare"
 	svcsdk "github.com/aws/aws-sdk-go-v2/service/apigateway"
 	"github.com/aws/aws-sdk-go/aws"
 
+	"github.com/aws-controllers-k8s/apigateway-controller/pkg/cors"
+	"github.com/aws-controllers-k8s/apigateway-controller/pkg/util"
 	"github.com/aws-controllers-k8s/apigateway-controller/pkg/util/patch"
 )
 
@@ -78,3 +81,19 @@ func convertBoolMapToStringMap(requestParameters map[string]*bool) map[string]*s
 	}
 	return requestParametersMap
 }
+
+// customPreCompare ignores the drift of the method at the spec paths listed
+// in the ignore-drift annotation, e.g.
+// spec.requestParameters.method.request.header.* for headers added by
+// another tool.
+func customPreCompare(a, b *resource) {
+	util.IgnoreDrift(a.ko, b.ko)
+}
+
+// syncCORS updates the CORS preflight that the Resource of the method
+// maintains from its spec.cors, if any, once the method was put or deleted,
+// see cors.SyncMethod.
+func (rm *resourceManager) syncCORS(ctx context.Context, r *resource, deleted bool) error {
+	return cors.SyncMethod(ctx, rm.sdkapi, rm.metrics, r.ko.Spec.RestAPIID, r.ko.Spec.ResourceID,
+		aws.StringValue(r.ko.Spec.HTTPMethod), deleted)
+}
@@ -37,6 +37,14 @@ import (
 func (rm *resourceManager) ClearResolvedReferences(res acktypes.AWSResource) acktypes.AWSResource {
 	ko := rm.concreteResource(res).ko.DeepCopy()
 
+	if ko.Spec.AuthorizerRef != nil {
+		ko.Spec.AuthorizerID = nil
+	}
+
+	if ko.Spec.RequestValidatorRef != nil {
+		ko.Spec.RequestValidatorID = nil
+	}
+
 	if ko.Spec.ResourceRef != nil {
 		ko.Spec.ResourceID = nil
 	}
@@ -64,6 +72,18 @@ func (rm *resourceManager) ResolveReferences(
 
 	resourceHasReferences := false
 	err := validateReferenceFields(ko)
+	if fieldHasReferences, err := rm.resolveReferenceForAuthorizerID(ctx, apiReader, ko); err != nil {
+		return &resource{ko}, (resourceHasReferences || fieldHasReferences), err
+	} else {
+		resourceHasReferences = resourceHasReferences || fieldHasReferences
+	}
+
+	if fieldHasReferences, err := rm.resolveReferenceForRequestValidatorID(ctx, apiReader, ko); err != nil {
+		return &resource{ko}, (resourceHasReferences || fieldHasReferences), err
+	} else {
+		resourceHasReferences = resourceHasReferences || fieldHasReferences
+	}
+
 	if fieldHasReferences, err := rm.resolveReferenceForResourceID(ctx, apiReader, ko); err != nil {
 		return &resource{ko}, (resourceHasReferences || fieldHasReferences), err
 	} else {
@@ -83,6 +103,14 @@ func (rm *resourceManager) ResolveReferences(
 // identifier field.
 func validateReferenceFields(ko *svcapitypes.Method) error {
 
+	if ko.Spec.AuthorizerRef != nil && ko.Spec.AuthorizerID != nil {
+		return ackerr.ResourceReferenceAndIDNotSupportedFor("AuthorizerID", "AuthorizerRef")
+	}
+
+	if ko.Spec.RequestValidatorRef != nil && ko.Spec.RequestValidatorID != nil {
+		return ackerr.ResourceReferenceAndIDNotSupportedFor("RequestValidatorID", "RequestValidatorRef")
+	}
+
 	if ko.Spec.ResourceRef != nil && ko.Spec.ResourceID != nil {
 		return ackerr.ResourceReferenceAndIDNotSupportedFor("ResourceID", "ResourceRef")
 	}
@@ -99,6 +127,172 @@ func validateReferenceFields(ko *svcapitypes.Method) error {
 	return nil
 }
 
+// resolveReferenceForAuthorizerID reads the resource referenced
+// from AuthorizerRef field and sets the AuthorizerID
+// from referenced resource. Returns a boolean indicating whether a reference
+// contains references, or an error
+func (rm *resourceManager) resolveReferenceForAuthorizerID(
+	ctx context.Context,
+	apiReader client.Reader,
+	ko *svcapitypes.Method,
+) (hasReferences bool, err error) {
+	if ko.Spec.AuthorizerRef != nil && ko.Spec.AuthorizerRef.From != nil {
+		hasReferences = true
+		arr := ko.Spec.AuthorizerRef.From
+		if arr.Name == nil || *arr.Name == "" {
+			return hasReferences, fmt.Errorf("provided resource reference is nil or empty: AuthorizerRef")
+		}
+		namespace := ko.ObjectMeta.GetNamespace()
+		if arr.Namespace != nil && *arr.Namespace != "" {
+			namespace = *arr.Namespace
+		}
+		obj := &svcapitypes.Authorizer{}
+		if err := getReferencedResourceState_Authorizer(ctx, apiReader, obj, *arr.Name, namespace); err != nil {
+			return hasReferences, err
+		}
+		ko.Spec.AuthorizerID = (*string)(obj.Status.ID)
+	}
+
+	return hasReferences, nil
+}
+
+// getReferencedResourceState_Authorizer looks up whether a referenced resource
+// exists and is in a ACK.ResourceSynced=True state. If the referenced resource does exist and is
+// in a Synced state, returns nil, otherwise returns `ackerr.ResourceReferenceTerminalFor` or
+// `ResourceReferenceNotSyncedFor` depending on if the resource is in a Terminal state.
+func getReferencedResourceState_Authorizer(
+	ctx context.Context,
+	apiReader client.Reader,
+	obj *svcapitypes.Authorizer,
+	name string, // the Kubernetes name of the referenced resource
+	namespace string, // the Kubernetes namespace of the referenced resource
+) error {
+	namespacedName := types.NamespacedName{
+		Namespace: namespace,
+		Name:      name,
+	}
+	err := apiReader.Get(ctx, namespacedName, obj)
+	if err != nil {
+		return err
+	}
+	var refResourceTerminal bool
+	for _, cond := range obj.Status.Conditions {
+		if cond.Type == ackv1alpha1.ConditionTypeTerminal &&
+			cond.Status == corev1.ConditionTrue {
+			return ackerr.ResourceReferenceTerminalFor(
+				"Authorizer",
+				namespace, name)
+		}
+	}
+	if refResourceTerminal {
+		return ackerr.ResourceReferenceTerminalFor(
+			"Authorizer",
+			namespace, name)
+	}
+	var refResourceSynced bool
+	for _, cond := range obj.Status.Conditions {
+		if cond.Type == ackv1alpha1.ConditionTypeResourceSynced &&
+			cond.Status == corev1.ConditionTrue {
+			refResourceSynced = true
+		}
+	}
+	if !refResourceSynced {
+		return ackerr.ResourceReferenceNotSyncedFor(
+			"Authorizer",
+			namespace, name)
+	}
+	if obj.Status.ID == nil {
+		return ackerr.ResourceReferenceMissingTargetFieldFor(
+			"Authorizer",
+			namespace, name,
+			"Status.ID")
+	}
+	return nil
+}
+
+// resolveReferenceForRequestValidatorID reads the resource referenced
+// from RequestValidatorRef field and sets the RequestValidatorID
+// from referenced resource. Returns a boolean indicating whether a reference
+// contains references, or an error
+func (rm *resourceManager) resolveReferenceForRequestValidatorID(
+	ctx context.Context,
+	apiReader client.Reader,
+	ko *svcapitypes.Method,
+) (hasReferences bool, err error) {
+	if ko.Spec.RequestValidatorRef != nil && ko.Spec.RequestValidatorRef.From != nil {
+		hasReferences = true
+		arr := ko.Spec.RequestValidatorRef.From
+		if arr.Name == nil || *arr.Name == "" {
+			return hasReferences, fmt.Errorf("provided resource reference is nil or empty: RequestValidatorRef")
+		}
+		namespace := ko.ObjectMeta.GetNamespace()
+		if arr.Namespace != nil && *arr.Namespace != "" {
+			namespace = *arr.Namespace
+		}
+		obj := &svcapitypes.RequestValidator{}
+		if err := getReferencedResourceState_RequestValidator(ctx, apiReader, obj, *arr.Name, namespace); err != nil {
+			return hasReferences, err
+		}
+		ko.Spec.RequestValidatorID = (*string)(obj.Status.ID)
+	}
+
+	return hasReferences, nil
+}
+
+// getReferencedResourceState_RequestValidator looks up whether a referenced resource
+// exists and is in a ACK.ResourceSynced=True state. If the referenced resource does exist and is
+// in a Synced state, returns nil, otherwise returns `ackerr.ResourceReferenceTerminalFor` or
+// `ResourceReferenceNotSyncedFor` depending on if the resource is in a Terminal state.
+func getReferencedResourceState_RequestValidator(
+	ctx context.Context,
+	apiReader client.Reader,
+	obj *svcapitypes.RequestValidator,
+	name string, // the Kubernetes name of the referenced resource
+	namespace string, // the Kubernetes namespace of the referenced resource
+) error {
+	namespacedName := types.NamespacedName{
+		Namespace: namespace,
+		Name:      name,
+	}
+	err := apiReader.Get(ctx, namespacedName, obj)
+	if err != nil {
+		return err
+	}
+	var refResourceTerminal bool
+	for _, cond := range obj.Status.Conditions {
+		if cond.Type == ackv1alpha1.ConditionTypeTerminal &&
+			cond.Status == corev1.ConditionTrue {
+			return ackerr.ResourceReferenceTerminalFor(
+				"RequestValidator",
+				namespace, name)
+		}
+	}
+	if refResourceTerminal {
+		return ackerr.ResourceReferenceTerminalFor(
+			"RequestValidator",
+			namespace, name)
+	}
+	var refResourceSynced bool
+	for _, cond := range obj.Status.Conditions {
+		if cond.Type == ackv1alpha1.ConditionTypeResourceSynced &&
+			cond.Status == corev1.ConditionTrue {
+			refResourceSynced = true
+		}
+	}
+	if !refResourceSynced {
+		return ackerr.ResourceReferenceNotSyncedFor(
+			"RequestValidator",
+			namespace, name)
+	}
+	if obj.Status.ID == nil {
+		return ackerr.ResourceReferenceMissingTargetFieldFor(
+			"RequestValidator",
+			namespace, name,
+			"Status.ID")
+	}
+	return nil
+}
+
 // resolveReferenceForResourceID reads the resource referenced
 // from ResourceRef field and sets the ResourceID
 // from referenced resource. Returns a boolean indicating whether a reference
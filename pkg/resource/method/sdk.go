@@ -426,6 +426,9 @@ func (rm *resourceManager) sdkCreate(
 	}
 
 	rm.setStatusDefaults(ko)
+	if err := rm.syncCORS(ctx, &resource{ko}, false); err != nil {
+		return &resource{ko}, err
+	}
 	return &resource{ko}, nil
 }
 
@@ -685,6 +688,9 @@ func (rm *resourceManager) sdkDelete(
 	_ = resp
 	resp, err = rm.sdkapi.DeleteMethod(ctx, input)
 	rm.metrics.RecordAPICall("DELETE", "DeleteMethod", err)
+	if err == nil {
+		err = rm.syncCORS(ctx, r, true)
+	}
 	return nil, err
 }
 
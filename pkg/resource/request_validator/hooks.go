@@ -0,0 +1,53 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package request_validator
+
+import (
+	"strconv"
+
+	"github.com/aws-controllers-k8s/runtime/pkg/compare"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	svcsdk "github.com/aws/aws-sdk-go-v2/service/apigateway"
+
+	"github.com/aws-controllers-k8s/apigateway-controller/pkg/util"
+	"github.com/aws-controllers-k8s/apigateway-controller/pkg/util/patch"
+)
+
+func updateRequestValidatorInput(desired *resource, input *svcsdk.UpdateRequestValidatorInput, delta *compare.Delta) {
+	desiredSpec := desired.ko.Spec
+
+	var patchSet patch.Set
+	if delta.DifferentAt("Spec.Name") {
+		patchSet.Replace("/name", desiredSpec.Name)
+	}
+	if delta.DifferentAt("Spec.ValidateRequestBody") {
+		patchSet.Replace("/validateRequestBody", aws.String(strconv.FormatBool(aws.ToBool(desiredSpec.ValidateRequestBody))))
+	}
+	if delta.DifferentAt("Spec.ValidateRequestParameters") {
+		patchSet.Replace("/validateRequestParameters", aws.String(strconv.FormatBool(aws.ToBool(desiredSpec.ValidateRequestParameters))))
+	}
+	input.PatchOperations = patchSet.GetPatchOperations()
+}
+
+// customPreCompare treats unset validation flags as false, which is what API
+// Gateway reports for them.
+func customPreCompare(a, b *resource) {
+	util.IgnoreDrift(a.ko, b.ko)
+	if a.ko.Spec.ValidateRequestBody == nil && !aws.ToBool(b.ko.Spec.ValidateRequestBody) {
+		b.ko.Spec.ValidateRequestBody = nil
+	}
+	if a.ko.Spec.ValidateRequestParameters == nil && !aws.ToBool(b.ko.Spec.ValidateRequestParameters) {
+		b.ko.Spec.ValidateRequestParameters = nil
+	}
+}
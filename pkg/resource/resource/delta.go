@@ -42,7 +42,11 @@ func newResourceDelta(
 		delta.Add("", a, b)
 		return delta
 	}
+	customPreCompare(a, b)
 
+	if !reflect.DeepEqual(a.ko.Spec.CORS, b.ko.Spec.CORS) {
+		delta.Add("Spec.CORS", a.ko.Spec.CORS, b.ko.Spec.CORS)
+	}
 	if ackcompare.HasNilDifference(a.ko.Spec.ParentID, b.ko.Spec.ParentID) {
 		delta.Add("Spec.ParentID", a.ko.Spec.ParentID, b.ko.Spec.ParentID)
 	} else if a.ko.Spec.ParentID != nil && b.ko.Spec.ParentID != nil {
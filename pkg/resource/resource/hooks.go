@@ -14,9 +14,15 @@
 package resource
 
 import (
+	"context"
+
 	"github.com/aws-controllers-k8s/runtime/pkg/compare"
 	svcsdk "github.com/aws/aws-sdk-go-v2/service/apigateway"
+	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/apigateway/types"
 
+	svcapitypes "github.com/aws-controllers-k8s/apigateway-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/apigateway-controller/pkg/cors"
+	"github.com/aws-controllers-k8s/apigateway-controller/pkg/util"
 	"github.com/aws-controllers-k8s/apigateway-controller/pkg/util/patch"
 )
 
@@ -31,3 +37,25 @@ func updateResourceInput(desired *resource, input *svcsdk.UpdateResourceInput, d
 	}
 	input.PatchOperations = patchSet.GetPatchOperations()
 }
+
+// setObservedCORS replaces the CORS configuration of the latest resource with
+// an empty one when the methods of the resource differ from the desired CORS
+// configuration, so that the difference shows up in the delta.
+func setObservedCORS(ko *svcapitypes.Resource, methods map[string]svcsdktypes.Method) {
+	if !cors.InSync(ko.Spec.CORS, methods) {
+		ko.Spec.CORS = &svcapitypes.CORSConfiguration{}
+	}
+}
+
+// syncCORS maintains the OPTIONS method that answers CORS preflight requests
+// for the resource and the CORS headers of its other methods, see cors.Sync.
+func (rm *resourceManager) syncCORS(ctx context.Context, r *resource) error {
+	return cors.Sync(ctx, rm.sdkapi, rm.metrics, r.ko.Spec.RestAPIID, r.ko.Status.ID, r.ko.Spec.CORS)
+}
+
+// customPreCompare ignores the drift of the resource at the spec paths listed
+// in the ignore-drift annotation, e.g. spec.cors, so that an OPTIONS method
+// edited in the console is not put back.
+func customPreCompare(a, b *resource) {
+	util.IgnoreDrift(a.ko, b.ko)
+}
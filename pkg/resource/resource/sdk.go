@@ -73,6 +73,7 @@ func (rm *resourceManager) sdkFind(
 	if err != nil {
 		return nil, err
 	}
+	input.Embed = []string{"methods"}
 
 	var resp *svcsdk.GetResourceOutput
 	resp, err = rm.sdkapi.GetResource(ctx, input)
@@ -111,6 +112,7 @@ func (rm *resourceManager) sdkFind(
 	}
 
 	rm.setStatusDefaults(ko)
+	setObservedCORS(ko, resp.ResourceMethods)
 	return &resource{ko}, nil
 }
 
@@ -191,6 +193,11 @@ func (rm *resourceManager) sdkCreate(
 	}
 
 	rm.setStatusDefaults(ko)
+	if ko.Spec.CORS != nil {
+		if err := rm.syncCORS(ctx, &resource{ko}); err != nil {
+			return &resource{ko}, err
+		}
+	}
 	return &resource{ko}, nil
 }
 
@@ -228,6 +235,14 @@ func (rm *resourceManager) sdkUpdate(
 	defer func() {
 		exit(err)
 	}()
+	if delta.DifferentAt("Spec.CORS") {
+		if err := rm.syncCORS(ctx, desired); err != nil {
+			return nil, err
+		}
+	}
+	if !delta.DifferentExcept("Spec.CORS") {
+		return desired, nil
+	}
 	input, err := rm.newUpdateRequestPayload(ctx, desired, delta)
 	if err != nil {
 		return nil, err
@@ -436,7 +451,6 @@ func (rm *resourceManager) terminalAWSError(err error) bool {
 	}
 	switch terminalErr.ErrorCode() {
 	case "BadRequestException",
-		"ConflictException",
 		"NotFoundException",
 		"InvalidParameter":
 		return true
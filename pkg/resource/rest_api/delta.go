@@ -118,6 +118,16 @@ func newResourceDelta(
 			delta.Add("Spec.Policy", a.ko.Spec.Policy, b.ko.Spec.Policy)
 		}
 	}
+	if !reflect.DeepEqual(a.ko.Spec.PolicySpec, b.ko.Spec.PolicySpec) {
+		delta.Add("Spec.PolicySpec", a.ko.Spec.PolicySpec, b.ko.Spec.PolicySpec)
+	}
+	if ackcompare.HasNilDifference(a.ko.Spec.ReplacementPolicy, b.ko.Spec.ReplacementPolicy) {
+		delta.Add("Spec.ReplacementPolicy", a.ko.Spec.ReplacementPolicy, b.ko.Spec.ReplacementPolicy)
+	} else if a.ko.Spec.ReplacementPolicy != nil && b.ko.Spec.ReplacementPolicy != nil {
+		if *a.ko.Spec.ReplacementPolicy != *b.ko.Spec.ReplacementPolicy {
+			delta.Add("Spec.ReplacementPolicy", a.ko.Spec.ReplacementPolicy, b.ko.Spec.ReplacementPolicy)
+		}
+	}
 	desiredACKTags, _ := convertToOrderedACKTags(a.ko.Spec.Tags)
 	latestACKTags, _ := convertToOrderedACKTags(b.ko.Spec.Tags)
 	if !ackcompare.MapStringStringEqual(desiredACKTags, latestACKTags) {
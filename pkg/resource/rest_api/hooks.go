@@ -14,11 +14,13 @@
 package rest_api
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"strconv"
 
 	"github.com/aws-controllers-k8s/runtime/pkg/compare"
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/apigateway"
 
@@ -30,10 +32,38 @@ import (
 
 var syncTags = tags.SyncTags
 
+// immutableFieldPaths are the fields of a REST API that API Gateway cannot
+// update in place.
+var immutableFieldPaths = []string{"Spec.CloneFrom", "Spec.Version"}
+
 func arnForResource(desired *svcapitypes.RestAPI) (string, error) {
 	return util.ARNForResource(desired.Status.ACKResourceMetadata, fmt.Sprintf("/restapis/%s", *desired.Status.ID))
 }
 
+// desiredPolicy returns the policy of a REST API, rendered from
+// spec.policySpec when it is set, which spec.policy cannot be along with.
+func desiredPolicy(ko *svcapitypes.RestAPI) (*string, error) {
+	if ko.Spec.PolicySpec == nil {
+		return ko.Spec.Policy, nil
+	}
+	if ko.Spec.Policy != nil {
+		return nil, ackerr.NewTerminalError(errors.New("spec.policy and spec.policySpec are mutually exclusive"))
+	}
+	policy, err := util.RenderRestAPIPolicy(ko.Spec.PolicySpec, ko.Spec.EndpointConfiguration)
+	if err != nil {
+		return nil, ackerr.NewTerminalError(err)
+	}
+	return &policy, nil
+}
+
+// clearRenderedPolicy leaves the policy rendered from spec.policySpec out of
+// the spec, where spec.policy cannot be set along with spec.policySpec.
+func clearRenderedPolicy(ko *svcapitypes.RestAPI) {
+	if ko.Spec.PolicySpec != nil {
+		ko.Spec.Policy = nil
+	}
+}
+
 func updateRestAPIInput(desired, latest *resource, input *apigateway.UpdateRestApiInput, delta *compare.Delta) error {
 	latestSpec := latest.ko.Spec
 	desiredSpec := desired.ko.Spec
@@ -89,16 +119,68 @@ func updateRestAPIInput(desired, latest *resource, input *apigateway.UpdateRestA
 		patchSet.Replace("/name", desiredSpec.Name)
 	}
 	if delta.DifferentAt("Spec.Policy") {
-		patchSet.Replace("/policy", desiredSpec.Policy)
+		policy, err := desiredPolicy(desired.ko)
+		if err != nil {
+			return err
+		}
+		patchSet.Replace("/policy", policy)
 	}
 	input.PatchOperations = patchSet.GetPatchOperations()
 	return nil
 }
 
+// customPreCompare ignores the differences between the policy of the REST API,
+// written in spec.policy or rendered from spec.policySpec, and the policy
+// returned by API Gateway, re-serialized and escaped, when they are the same
+// IAM policy document. The drift at the spec paths listed in the ignore-drift
+// annotation is ignored afterwards, so that ignoring spec.policy also covers
+// the policy rendered from spec.policySpec.
 func customPreCompare(a, b *resource) {
+	// An invalid policy spec is reported by updateRestAPIInput.
+	if policy, err := desiredPolicy(a.ko); err == nil && policy != nil {
+		switch {
+		case b.ko.Spec.Policy != nil && util.PolicyEqual(*policy, *b.ko.Spec.Policy, aws.ToString(b.ko.Status.ID)):
+			b.ko.Spec.Policy = a.ko.Spec.Policy
+		case b.ko.Spec.Policy == nil && a.ko.Spec.PolicySpec != nil:
+			// The rendered policy is not in spec.policy of desired, so the
+			// missing policy must be marked as a difference.
+			b.ko.Spec.Policy = aws.String("")
+		}
+	}
+	util.IgnoreDrift(a.ko, b.ko)
 	if a.ko.Spec.EndpointConfiguration == nil && b.ko.Spec.EndpointConfiguration != nil {
 		a.ko.Spec.EndpointConfiguration = &svcapitypes.EndpointConfiguration{}
 	} else if a.ko.Spec.EndpointConfiguration != nil && b.ko.Spec.EndpointConfiguration == nil {
 		b.ko.Spec.EndpointConfiguration = &svcapitypes.EndpointConfiguration{}
 	}
 }
+
+// requiresReplacement returns true if any field that cannot be updated in place
+// differs between the desired and latest state.
+func requiresReplacement(delta *compare.Delta) bool {
+	return len(util.ChangedFields(delta, immutableFieldPaths...)) > 0
+}
+
+// replaceRestAPI creates a new REST API from the desired spec and deletes the
+// existing one, or returns a terminal error if spec.replacementPolicy does not
+// allow recreating the REST API. The new REST API gets a new ID; resources
+// referencing it through restAPIRef resolve the new ID on their next
+// reconcile and are created again under it.
+func (rm *resourceManager) replaceRestAPI(
+	ctx context.Context,
+	desired *resource,
+	latest *resource,
+	delta *compare.Delta,
+) (*resource, error) {
+	if !util.RecreateRequested(desired.ko.Spec.ReplacementPolicy) {
+		return nil, util.ImmutableFieldsChangedError(util.ChangedFields(delta, immutableFieldPaths...))
+	}
+	created, err := rm.sdkCreate(ctx, desired)
+	if err != nil {
+		return nil, err
+	}
+	// The new REST API is returned even if deleting the old one fails so that
+	// its ID is recorded in the status and it is not created a second time.
+	_, err = rm.sdkDelete(ctx, latest)
+	return created, err
+}
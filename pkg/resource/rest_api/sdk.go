@@ -216,6 +216,9 @@ func (rm *resourceManager) sdkCreate(
 	if err != nil {
 		return nil, err
 	}
+	if input.Policy, err = desiredPolicy(desired.ko); err != nil {
+		return nil, err
+	}
 
 	var resp *svcsdk.CreateRestApiOutput
 	_ = resp
@@ -310,6 +313,7 @@ func (rm *resourceManager) sdkCreate(
 	}
 
 	rm.setStatusDefaults(ko)
+	clearRenderedPolicy(ko)
 	return &resource{ko}, nil
 }
 
@@ -389,6 +393,10 @@ func (rm *resourceManager) sdkUpdate(
 	defer func() {
 		exit(err)
 	}()
+	if requiresReplacement(delta) {
+		return rm.replaceRestAPI(ctx, desired, latest, delta)
+	}
+
 	if delta.DifferentAt("Spec.Tags") {
 		resourceARN, err := arnForResource(desired.ko)
 		if err != nil {
@@ -503,6 +511,7 @@ func (rm *resourceManager) sdkUpdate(
 	}
 
 	rm.setStatusDefaults(ko)
+	clearRenderedPolicy(ko)
 	return &resource{ko}, nil
 }
 
@@ -666,7 +675,6 @@ func (rm *resourceManager) terminalAWSError(err error) bool {
 	}
 	switch terminalErr.ErrorCode() {
 	case "BadRequestException",
-		"ConflictException",
 		"NotFoundException",
 		"InvalidParameter":
 		return true
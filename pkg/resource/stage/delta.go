@@ -111,6 +111,16 @@ func newResourceDelta(
 			delta.Add("Spec.DocumentationVersion", a.ko.Spec.DocumentationVersion, b.ko.Spec.DocumentationVersion)
 		}
 	}
+	if !reflect.DeepEqual(a.ko.Spec.DocumentationVersionRef, b.ko.Spec.DocumentationVersionRef) {
+		delta.Add("Spec.DocumentationVersionRef", a.ko.Spec.DocumentationVersionRef, b.ko.Spec.DocumentationVersionRef)
+	}
+	if ackcompare.HasNilDifference(a.ko.Spec.ReplacementPolicy, b.ko.Spec.ReplacementPolicy) {
+		delta.Add("Spec.ReplacementPolicy", a.ko.Spec.ReplacementPolicy, b.ko.Spec.ReplacementPolicy)
+	} else if a.ko.Spec.ReplacementPolicy != nil && b.ko.Spec.ReplacementPolicy != nil {
+		if *a.ko.Spec.ReplacementPolicy != *b.ko.Spec.ReplacementPolicy {
+			delta.Add("Spec.ReplacementPolicy", a.ko.Spec.ReplacementPolicy, b.ko.Spec.ReplacementPolicy)
+		}
+	}
 	if ackcompare.HasNilDifference(a.ko.Spec.RestAPIID, b.ko.Spec.RestAPIID) {
 		delta.Add("Spec.RestAPIID", a.ko.Spec.RestAPIID, b.ko.Spec.RestAPIID)
 	} else if a.ko.Spec.RestAPIID != nil && b.ko.Spec.RestAPIID != nil {
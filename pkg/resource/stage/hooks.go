@@ -14,9 +14,12 @@
 package stage
 
 import (
+	"context"
 	"fmt"
 	"strconv"
+	"strings"
 
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
 	"github.com/aws-controllers-k8s/runtime/pkg/compare"
 	svcsdk "github.com/aws/aws-sdk-go-v2/service/apigateway"
 	"github.com/aws/aws-sdk-go/aws"
@@ -34,6 +37,55 @@ func arnForResource(desired *svcapitypes.Stage) (string, error) {
 		fmt.Sprintf("/restapis/%s/stages/%s", *desired.Spec.RestAPIID, *desired.Spec.StageName))
 }
 
+// setResourceARN records the ARN of the stage in the resource status. The stage
+// name is part of the identifier of a stage, so the recorded ARN is how the
+// controller finds the previously managed stage after spec.stageName changes.
+func setResourceARN(ko *svcapitypes.Stage) error {
+	resourceARN, err := arnForResource(ko)
+	if err != nil {
+		return err
+	}
+	ko.Status.ACKResourceMetadata.ARN = (*ackv1alpha1.AWSResourceName)(&resourceARN)
+	return nil
+}
+
+// replacedStageName returns the name of the stage previously managed by the
+// resource if spec.stageName has changed since, or a terminal error if
+// spec.replacementPolicy does not allow recreating the stage.
+func replacedStageName(desired *resource) (*string, error) {
+	meta := desired.ko.Status.ACKResourceMetadata
+	if meta == nil || meta.ARN == nil || desired.ko.Spec.RestAPIID == nil {
+		return nil, nil
+	}
+	desiredARN, err := arnForResource(desired.ko)
+	if err != nil {
+		return nil, err
+	}
+	if string(*meta.ARN) == desiredARN {
+		return nil, nil
+	}
+	prefix := strings.TrimSuffix(desiredARN, *desired.ko.Spec.StageName)
+	stageName, found := strings.CutPrefix(string(*meta.ARN), prefix)
+	if !found || stageName == "" {
+		return nil, nil
+	}
+	if !util.RecreateRequested(desired.ko.Spec.ReplacementPolicy) {
+		return nil, util.ImmutableFieldsChangedError([]string{"Spec.StageName"})
+	}
+	return &stageName, nil
+}
+
+// deleteReplacedStage deletes the stage previously managed by the resource once
+// the stage with the new name has been created.
+func (rm *resourceManager) deleteReplacedStage(ctx context.Context, restAPIID, stageName *string) error {
+	_, err := rm.sdkapi.DeleteStage(ctx, &svcsdk.DeleteStageInput{
+		RestApiId: restAPIID,
+		StageName: stageName,
+	})
+	rm.metrics.RecordAPICall("DELETE", "DeleteStage", err)
+	return err
+}
+
 func updateStageInput(desired, latest *resource, input *svcsdk.UpdateStageInput, delta *compare.Delta) {
 	latestSpec := latest.ko.Spec
 	desiredSpec := desired.ko.Spec
@@ -118,6 +170,7 @@ func updateCanarySettings(delta *compare.Delta, desiredSpec, latestSpec svcapity
 }
 
 func customPreCompare(a, b *resource) {
+	util.IgnoreDrift(a.ko, b.ko)
 	if a.ko.Spec.Variables == nil && b.ko.Spec.Variables != nil {
 		a.ko.Spec.Variables = map[string]*string{}
 	} else if a.ko.Spec.Variables != nil && b.ko.Spec.Variables == nil {
@@ -37,6 +37,10 @@ import (
 func (rm *resourceManager) ClearResolvedReferences(res acktypes.AWSResource) acktypes.AWSResource {
 	ko := rm.concreteResource(res).ko.DeepCopy()
 
+	if ko.Spec.DocumentationVersionRef != nil {
+		ko.Spec.DocumentationVersion = nil
+	}
+
 	if ko.Spec.RestAPIRef != nil {
 		ko.Spec.RestAPIID = nil
 	}
@@ -60,6 +64,12 @@ func (rm *resourceManager) ResolveReferences(
 
 	resourceHasReferences := false
 	err := validateReferenceFields(ko)
+	if fieldHasReferences, err := rm.resolveReferenceForDocumentationVersion(ctx, apiReader, ko); err != nil {
+		return &resource{ko}, (resourceHasReferences || fieldHasReferences), err
+	} else {
+		resourceHasReferences = resourceHasReferences || fieldHasReferences
+	}
+
 	if fieldHasReferences, err := rm.resolveReferenceForRestAPIID(ctx, apiReader, ko); err != nil {
 		return &resource{ko}, (resourceHasReferences || fieldHasReferences), err
 	} else {
@@ -73,6 +83,10 @@ func (rm *resourceManager) ResolveReferences(
 // identifier field.
 func validateReferenceFields(ko *svcapitypes.Stage) error {
 
+	if ko.Spec.DocumentationVersionRef != nil && ko.Spec.DocumentationVersion != nil {
+		return ackerr.ResourceReferenceAndIDNotSupportedFor("DocumentationVersion", "DocumentationVersionRef")
+	}
+
 	if ko.Spec.RestAPIRef != nil && ko.Spec.RestAPIID != nil {
 		return ackerr.ResourceReferenceAndIDNotSupportedFor("RestAPIID", "RestAPIRef")
 	}
@@ -82,6 +96,89 @@ func validateReferenceFields(ko *svcapitypes.Stage) error {
 	return nil
 }
 
+// resolveReferenceForDocumentationVersion reads the resource referenced
+// from DocumentationVersionRef field and sets the DocumentationVersion
+// from referenced resource. Returns a boolean indicating whether a reference
+// contains references, or an error
+func (rm *resourceManager) resolveReferenceForDocumentationVersion(
+	ctx context.Context,
+	apiReader client.Reader,
+	ko *svcapitypes.Stage,
+) (hasReferences bool, err error) {
+	if ko.Spec.DocumentationVersionRef != nil && ko.Spec.DocumentationVersionRef.From != nil {
+		hasReferences = true
+		arr := ko.Spec.DocumentationVersionRef.From
+		if arr.Name == nil || *arr.Name == "" {
+			return hasReferences, fmt.Errorf("provided resource reference is nil or empty: DocumentationVersionRef")
+		}
+		namespace := ko.ObjectMeta.GetNamespace()
+		if arr.Namespace != nil && *arr.Namespace != "" {
+			namespace = *arr.Namespace
+		}
+		obj := &svcapitypes.DocumentationVersion{}
+		if err := getReferencedResourceState_DocumentationVersion(ctx, apiReader, obj, *arr.Name, namespace); err != nil {
+			return hasReferences, err
+		}
+		ko.Spec.DocumentationVersion = (*string)(obj.Spec.DocumentationVersion)
+	}
+
+	return hasReferences, nil
+}
+
+// getReferencedResourceState_DocumentationVersion looks up whether a referenced resource
+// exists and is in a ACK.ResourceSynced=True state. If the referenced resource does exist and is
+// in a Synced state, returns nil, otherwise returns `ackerr.ResourceReferenceTerminalFor` or
+// `ResourceReferenceNotSyncedFor` depending on if the resource is in a Terminal state.
+func getReferencedResourceState_DocumentationVersion(
+	ctx context.Context,
+	apiReader client.Reader,
+	obj *svcapitypes.DocumentationVersion,
+	name string, // the Kubernetes name of the referenced resource
+	namespace string, // the Kubernetes namespace of the referenced resource
+) error {
+	namespacedName := types.NamespacedName{
+		Namespace: namespace,
+		Name:      name,
+	}
+	err := apiReader.Get(ctx, namespacedName, obj)
+	if err != nil {
+		return err
+	}
+	var refResourceTerminal bool
+	for _, cond := range obj.Status.Conditions {
+		if cond.Type == ackv1alpha1.ConditionTypeTerminal &&
+			cond.Status == corev1.ConditionTrue {
+			return ackerr.ResourceReferenceTerminalFor(
+				"DocumentationVersion",
+				namespace, name)
+		}
+	}
+	if refResourceTerminal {
+		return ackerr.ResourceReferenceTerminalFor(
+			"DocumentationVersion",
+			namespace, name)
+	}
+	var refResourceSynced bool
+	for _, cond := range obj.Status.Conditions {
+		if cond.Type == ackv1alpha1.ConditionTypeResourceSynced &&
+			cond.Status == corev1.ConditionTrue {
+			refResourceSynced = true
+		}
+	}
+	if !refResourceSynced {
+		return ackerr.ResourceReferenceNotSyncedFor(
+			"DocumentationVersion",
+			namespace, name)
+	}
+	if obj.Spec.DocumentationVersion == nil {
+		return ackerr.ResourceReferenceMissingTargetFieldFor(
+			"DocumentationVersion",
+			namespace, name,
+			"Spec.DocumentationVersion")
+	}
+	return nil
+}
+
 // resolveReferenceForRestAPIID reads the resource referenced
 // from RestAPIRef field and sets the RestAPIID
 // from referenced resource. Returns a boolean indicating whether a reference
@@ -206,6 +206,9 @@ func (rm *resourceManager) sdkFind(
 	}
 
 	rm.setStatusDefaults(ko)
+	if err := setResourceARN(ko); err != nil {
+		return nil, err
+	}
 	return &resource{ko}, nil
 }
 
@@ -248,6 +251,10 @@ func (rm *resourceManager) sdkCreate(
 	defer func() {
 		exit(err)
 	}()
+	replacedStage, err := replacedStageName(desired)
+	if err != nil {
+		return nil, err
+	}
 	input, err := rm.newCreateRequestPayload(ctx, desired)
 	if err != nil {
 		return nil, err
@@ -380,6 +387,16 @@ func (rm *resourceManager) sdkCreate(
 	}
 
 	rm.setStatusDefaults(ko)
+	if err := setResourceARN(ko); err != nil {
+		return nil, err
+	}
+	if replacedStage != nil {
+		// The new stage is returned even if deleting the old one fails so that
+		// the ARN of the new stage is recorded in the status.
+		if err := rm.deleteReplacedStage(ctx, ko.Spec.RestAPIID, replacedStage); err != nil {
+			return &resource{ko}, err
+		}
+	}
 	return &resource{ko}, nil
 }
 
@@ -769,7 +786,6 @@ func (rm *resourceManager) terminalAWSError(err error) bool {
 	}
 	switch terminalErr.ErrorCode() {
 	case "BadRequestException",
-		"ConflictException",
 		"NotFoundException",
 		"InvalidParameter":
 		return true
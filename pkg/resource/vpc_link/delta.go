@@ -42,6 +42,7 @@ func newResourceDelta(
 		delta.Add("", a, b)
 		return delta
 	}
+	customPreCompare(delta, a, b)
 
 	if ackcompare.HasNilDifference(a.ko.Spec.Description, b.ko.Spec.Description) {
 		delta.Add("Spec.Description", a.ko.Spec.Description, b.ko.Spec.Description)
@@ -57,6 +58,13 @@ func newResourceDelta(
 			delta.Add("Spec.Name", a.ko.Spec.Name, b.ko.Spec.Name)
 		}
 	}
+	if ackcompare.HasNilDifference(a.ko.Spec.ReplacementPolicy, b.ko.Spec.ReplacementPolicy) {
+		delta.Add("Spec.ReplacementPolicy", a.ko.Spec.ReplacementPolicy, b.ko.Spec.ReplacementPolicy)
+	} else if a.ko.Spec.ReplacementPolicy != nil && b.ko.Spec.ReplacementPolicy != nil {
+		if *a.ko.Spec.ReplacementPolicy != *b.ko.Spec.ReplacementPolicy {
+			delta.Add("Spec.ReplacementPolicy", a.ko.Spec.ReplacementPolicy, b.ko.Spec.ReplacementPolicy)
+		}
+	}
 	desiredACKTags, _ := convertToOrderedACKTags(a.ko.Spec.Tags)
 	latestACKTags, _ := convertToOrderedACKTags(b.ko.Spec.Tags)
 	if !ackcompare.MapStringStringEqual(desiredACKTags, latestACKTags) {
@@ -69,6 +77,9 @@ func newResourceDelta(
 			delta.Add("Spec.TargetARNs", a.ko.Spec.TargetARNs, b.ko.Spec.TargetARNs)
 		}
 	}
+	if !reflect.DeepEqual(a.ko.Spec.TargetRefs, b.ko.Spec.TargetRefs) {
+		delta.Add("Spec.TargetRefs", a.ko.Spec.TargetRefs, b.ko.Spec.TargetRefs)
+	}
 
 	return delta
 }
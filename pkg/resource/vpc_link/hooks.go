@@ -14,21 +14,44 @@
 package vpc_link
 
 import (
+	"context"
+	"errors"
 	"fmt"
 
 	"github.com/aws-controllers-k8s/runtime/pkg/compare"
 	"github.com/aws/aws-sdk-go-v2/service/apigateway"
+	"github.com/aws/smithy-go"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
 	ackrequeue "github.com/aws-controllers-k8s/runtime/pkg/requeue"
+	acktypes "github.com/aws-controllers-k8s/runtime/pkg/types"
 
 	svcapitypes "github.com/aws-controllers-k8s/apigateway-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/apigateway-controller/pkg/references"
 	"github.com/aws-controllers-k8s/apigateway-controller/pkg/tags"
 	"github.com/aws-controllers-k8s/apigateway-controller/pkg/util"
 	"github.com/aws-controllers-k8s/apigateway-controller/pkg/util/patch"
 )
 
+// +kubebuilder:rbac:groups=elbv2.services.k8s.aws,resources=loadbalancers,verbs=get;list
+// +kubebuilder:rbac:groups=elbv2.services.k8s.aws,resources=loadbalancers/status,verbs=get;list
+// +kubebuilder:rbac:groups="",resources=services,verbs=get;list;watch
+
 var syncTags = tags.SyncTags
 
+func init() {
+	references.RegisterResolver(GroupKind.Kind, targetRefsResolver{})
+}
+
+// immutableFieldPaths are the fields of a VPC link that API Gateway cannot
+// update in place.
+var immutableFieldPaths = []string{"Spec.TargetARNs"}
+
+// replacementPendingPath is the difference reported while status.replacedID
+// is set, since the runtime only updates resources differing in their spec.
+const replacementPendingPath = "Spec.ReplacementPolicy"
+
 func arnForResource(desired *svcapitypes.VPCLink) (string, error) {
 	return util.ARNForResource(desired.Status.ACKResourceMetadata, fmt.Sprintf("/vpclinks/%s", *desired.Status.ID))
 }
@@ -56,3 +79,204 @@ func updateVPCLinkInput(desired *resource, input *apigateway.UpdateVpcLinkInput,
 	}
 	input.PatchOperations = patchSet.GetPatchOperations()
 }
+
+// requiresReplacement returns true if any field that cannot be updated in place
+// differs between the desired and latest state.
+func requiresReplacement(delta *compare.Delta) bool {
+	return len(util.ChangedFields(delta, immutableFieldPaths...)) > 0
+}
+
+// replaceVPCLink creates a VPC link with the desired targets to replace the
+// current one, or returns a terminal error if spec.replacementPolicy does not
+// allow recreating the VPC link. The replaced VPC link is recorded in
+// status.replacedID and kept until the new one is AVAILABLE and no longer
+// used, see completeReplacement. If a previous replacement has not completed yet, the
+// VPC link it created is deleted instead, which is requeued while that VPC
+// link is PENDING.
+func (rm *resourceManager) replaceVPCLink(
+	ctx context.Context,
+	desired *resource,
+	latest *resource,
+	delta *compare.Delta,
+) (*resource, error) {
+	if !util.RecreateRequested(desired.ko.Spec.ReplacementPolicy) {
+		return nil, util.ImmutableFieldsChangedError(util.ChangedFields(delta, immutableFieldPaths...))
+	}
+	replacedID := latest.ko.Status.ID
+	if latest.ko.Status.ReplacedID != nil {
+		if err := validateDeleteState(latest); err != nil {
+			return nil, err
+		}
+		if err := rm.deleteVPCLink(ctx, latest.ko.Status.ID); err != nil {
+			return nil, err
+		}
+		replacedID = latest.ko.Status.ReplacedID
+	}
+	created, err := rm.sdkCreate(ctx, desired)
+	if err != nil {
+		return nil, err
+	}
+	created.ko.Status.ReplacedID = replacedID
+	return created, nil
+}
+
+// completeReplacement deletes the VPC link in status.replacedID once the VPC
+// link replacing it is AVAILABLE and no integration uses it anymore. The
+// integrations referencing the VPCLink through connectionRef are re-pointed
+// by their Integration resources, which resolve the ID of the new VPC link
+// when they are requeued. Until then, the update is requeued.
+func (rm *resourceManager) completeReplacement(ctx context.Context, desired, latest *resource) (*resource, error) {
+	if latest.ko.Status.Status == nil || *latest.ko.Status.Status != string(svcapitypes.VPCLinkStatus_SDK_AVAILABLE) {
+		return nil, ackrequeue.NeededAfter(
+			fmt.Errorf("VPC link %s replacing %s is not yet available", *latest.ko.Status.ID, *latest.ko.Status.ReplacedID),
+			ackrequeue.DefaultRequeueAfterDuration,
+		)
+	}
+	if err := rm.deleteVPCLink(ctx, latest.ko.Status.ReplacedID); err != nil {
+		if !isBadRequest(err) {
+			return nil, err
+		}
+		return nil, ackrequeue.NeededAfter(
+			fmt.Errorf("VPC link %s replaced by %s is still used by integrations: %w",
+				*latest.ko.Status.ReplacedID, *latest.ko.Status.ID, err),
+			ackrequeue.DefaultRequeueAfterDuration,
+		)
+	}
+	ko := desired.ko.DeepCopy()
+	latest.ko.Status.DeepCopyInto(&ko.Status)
+	ko.Status.ReplacedID = nil
+	return &resource{ko}, nil
+}
+
+// deleteVPCLink deletes the VPC link with the supplied ID, if there is one.
+func (rm *resourceManager) deleteVPCLink(ctx context.Context, id *string) error {
+	if id == nil {
+		return nil
+	}
+	_, err := rm.sdkapi.DeleteVpcLink(ctx, &apigateway.DeleteVpcLinkInput{VpcLinkId: id})
+	rm.metrics.RecordAPICall("DELETE", "DeleteVpcLink", err)
+	if err != nil && !isNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+func isNotFound(err error) bool {
+	var awsErr smithy.APIError
+	return errors.As(err, &awsErr) && awsErr.ErrorCode() == "NotFoundException"
+}
+
+// isBadRequest returns true for the error of DeleteVpcLink while integrations
+// still use the VPC link.
+func isBadRequest(err error) bool {
+	var awsErr smithy.APIError
+	return errors.As(err, &awsErr) && awsErr.ErrorCode() == "BadRequestException"
+}
+
+// customPreCompare ignores the drift of the VPC link at the spec paths listed
+// in the ignore-drift annotation, e.g. spec.description, which does not
+// require the link to be replaced. While a replacement is in progress, it adds
+// a difference at replacementPendingPath so that the runtime calls sdkUpdate,
+// which completes the replacement.
+func customPreCompare(delta *compare.Delta, a, b *resource) {
+	util.IgnoreDrift(a.ko, b.ko)
+	if b.ko.Status.ReplacedID != nil {
+		delta.Add(replacementPendingPath, a.ko.Spec.ReplacementPolicy, b.ko.Spec.ReplacementPolicy)
+	}
+}
+
+// targetRefsResolver resolves spec.targetRefs, which point either at ACK
+// ELBv2 LoadBalancers or at Kubernetes Services, into spec.targetARNs.
+type targetRefsResolver struct{}
+
+func (targetRefsResolver) ResolveReferences(
+	ctx context.Context,
+	apiReader client.Reader,
+	rm acktypes.AWSResourceManager,
+	res acktypes.AWSResource,
+) (acktypes.AWSResource, bool, error) {
+	r := rm.(*resourceManager)
+	ko := r.concreteResource(res).ko.DeepCopy()
+	if err := validateTargetRefs(ko); err != nil {
+		return &resource{ko}, len(ko.Spec.TargetRefs) > 0, err
+	}
+	hasReferences, err := r.resolveReferenceForTargetARNs(ctx, apiReader, ko)
+	return &resource{ko}, hasReferences, err
+}
+
+func (targetRefsResolver) ClearResolvedReferences(res acktypes.AWSResource) acktypes.AWSResource {
+	ko := res.(*resource).ko.DeepCopy()
+	if len(ko.Spec.TargetRefs) > 0 {
+		ko.Spec.TargetARNs = nil
+	}
+	return &resource{ko}
+}
+
+// validateTargetRefs validates that exactly one of spec.targetARNs and
+// spec.targetRefs is set.
+func validateTargetRefs(ko *svcapitypes.VPCLink) error {
+	if len(ko.Spec.TargetRefs) > 0 && len(ko.Spec.TargetARNs) > 0 {
+		return ackerr.ResourceReferenceAndIDNotSupportedFor("TargetARNs", "TargetRefs")
+	}
+	if len(ko.Spec.TargetRefs) == 0 && len(ko.Spec.TargetARNs) == 0 {
+		return ackerr.ResourceReferenceOrIDRequiredFor("TargetARNs", "TargetRefs")
+	}
+	return nil
+}
+
+// resolveReferenceForTargetARNs reads the load balancers referenced from
+// TargetRefs field and sets the TargetARNs from the referenced resources.
+// A reference either points at an ACK ELBv2 LoadBalancer, whose ARN is read
+// from Status.ACKResourceMetadata.ARN, or at a Kubernetes Service of type
+// LoadBalancer, whose network load balancer ARN is discovered from the
+// Service status. Returns a boolean indicating whether a reference contains
+// references, or an error
+func (rm *resourceManager) resolveReferenceForTargetARNs(
+	ctx context.Context,
+	apiReader client.Reader,
+	ko *svcapitypes.VPCLink,
+) (hasReferences bool, err error) {
+	var targetARNs []*string
+	for _, f0iter := range ko.Spec.TargetRefs {
+		if f0iter == nil {
+			continue
+		}
+		hasReferences = true
+		namespace := ko.ObjectMeta.GetNamespace()
+		switch {
+		case f0iter.LoadBalancerRef != nil && f0iter.LoadBalancerRef.From != nil:
+			arr := f0iter.LoadBalancerRef.From
+			if arr.Name == nil || *arr.Name == "" {
+				return hasReferences, fmt.Errorf("provided resource reference is nil or empty: TargetRefs.LoadBalancerRef")
+			}
+			if arr.Namespace != nil && *arr.Namespace != "" {
+				namespace = *arr.Namespace
+			}
+			lbARN, err := references.ResolveARN(ctx, apiReader, references.ELBv2LoadBalancerGVK, *arr.Name, namespace)
+			if err != nil {
+				return hasReferences, err
+			}
+			targetARNs = append(targetARNs, lbARN)
+		case f0iter.ServiceRef != nil:
+			ref := f0iter.ServiceRef
+			if ref.Name == nil || *ref.Name == "" {
+				return hasReferences, fmt.Errorf("provided resource reference is nil or empty: TargetRefs.ServiceRef")
+			}
+			if ref.Namespace != nil && *ref.Namespace != "" {
+				namespace = *ref.Namespace
+			}
+			lb, err := references.ResolveServiceLoadBalancer(ctx, apiReader, *ref.Name, namespace, string(rm.awsAccountID))
+			if err != nil {
+				return hasReferences, err
+			}
+			targetARNs = append(targetARNs, &lb.ARN)
+		default:
+			return hasReferences, fmt.Errorf("provided resource reference is nil or empty: TargetRefs")
+		}
+	}
+	if hasReferences {
+		ko.Spec.TargetARNs = targetARNs
+	}
+
+	return hasReferences, nil
+}
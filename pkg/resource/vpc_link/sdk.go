@@ -258,6 +258,13 @@ func (rm *resourceManager) sdkUpdate(
 	defer func() {
 		exit(err)
 	}()
+	if requiresReplacement(delta) {
+		return rm.replaceVPCLink(ctx, desired, latest, delta)
+	}
+	if latest.ko.Status.ReplacedID != nil {
+		return rm.completeReplacement(ctx, desired, latest)
+	}
+
 	if delta.DifferentAt("Spec.Tags") {
 		resourceARN, err := arnForResource(desired.ko)
 		if err != nil {
@@ -357,6 +364,9 @@ func (rm *resourceManager) sdkDelete(
 	if err := validateDeleteState(r); err != nil {
 		return r, err
 	}
+	if err := rm.deleteVPCLink(ctx, r.ko.Status.ReplacedID); err != nil {
+		return r, err
+	}
 
 	input, err := rm.newDeleteRequestPayload(r)
 	if err != nil {
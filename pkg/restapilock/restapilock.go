@@ -0,0 +1,212 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+// Package restapilock serializes the API Gateway calls mutating the same
+// RestAPI, made by the resource managers of all its resources, so that the
+// concurrent reconciles of the Resources, Methods, Integrations or Stages of
+// a RestAPI do not fail with concurrent modification conflicts. The
+// concurrent modification conflicts still met, caused by changes made outside
+// of the controller, are retried with a jittered requeue, while the other
+// conflicts are terminal for the resources whose spec must change to resolve
+// them.
+package restapilock
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	ackcfg "github.com/aws-controllers-k8s/runtime/pkg/config"
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	ackmetrics "github.com/aws-controllers-k8s/runtime/pkg/metrics"
+	ackrequeue "github.com/aws-controllers-k8s/runtime/pkg/requeue"
+	acktypes "github.com/aws-controllers-k8s/runtime/pkg/types"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	smithy "github.com/aws/smithy-go"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/go-logr/logr"
+
+	"github.com/aws-controllers-k8s/apigateway-controller/pkg/util"
+)
+
+// RequeueAfter is the minimum delay after which a resource whose call failed
+// with a concurrent modification conflict is reconciled again.
+const RequeueAfter = 5 * time.Second
+
+// RequeueJitter is the maximum random delay added to RequeueAfter, so that
+// the resources of a RestAPI conflicting together are not retried together.
+const RequeueJitter = 10 * time.Second
+
+// conflictErrorCode is the code of the errors of the calls conflicting with
+// other calls or with the state of the resources.
+const conflictErrorCode = "ConflictException"
+
+// ConcurrentModificationError is the error of a call that API Gateway
+// rejected because another call was modifying the same RestAPI. It is
+// returned wrapped in a requeue error.
+type ConcurrentModificationError struct {
+	err error
+}
+
+func (e *ConcurrentModificationError) Error() string {
+	return e.err.Error()
+}
+
+// Unwrap returns the ConflictException of the call.
+func (e *ConcurrentModificationError) Unwrap() error {
+	return e.err
+}
+
+// IsConcurrentModification returns whether err is a ConflictException caused
+// by a concurrent modification of the RestAPI, rather than by a conflict with
+// the state of the resources, e.g. an existing resource of the same name.
+func IsConcurrentModification(err error) bool {
+	var apiErr smithy.APIError
+	if !errors.As(err, &apiErr) || apiErr.ErrorCode() != conflictErrorCode {
+		return false
+	}
+	return strings.Contains(strings.ToLower(apiErr.ErrorMessage()), "concurrent modification")
+}
+
+// terminalConflictKinds are the kinds of the resources whose conflicts other
+// than concurrent modifications are terminal, e.g. a Stage or Model of the
+// same name that already exists, which a change of the spec or of the other
+// resources must resolve. The conflicts of the other resources are retried.
+var terminalConflictKinds = map[string]bool{
+	"DocumentationPart":    true,
+	"DocumentationVersion": true,
+	"Integration":          true,
+	"Model":                true,
+	"RequestValidator":     true,
+	"Resource":             true,
+	"RestAPI":              true,
+	"Stage":                true,
+}
+
+// conflictError returns the error the resource managers get for the error of
+// a mutating call. ConflictException is not among the terminal error codes of
+// any resource, so a conflict is classified here: a concurrent modification
+// is retried with a jittered requeue, as it clears once the other
+// modification is done, and any other conflict is terminal when terminal is
+// set.
+func conflictError(err error, terminal bool) error {
+	var apiErr smithy.APIError
+	if !errors.As(err, &apiErr) || apiErr.ErrorCode() != conflictErrorCode {
+		return err
+	}
+	if IsConcurrentModification(err) {
+		after := RequeueAfter + rand.N(RequeueJitter)
+		return ackrequeue.NeededAfter(&ConcurrentModificationError{err: err}, after)
+	}
+	if terminal {
+		return ackerr.NewTerminalError(err)
+	}
+	return err
+}
+
+// Locks holds the locks of the RestAPIs the resource managers mutate.
+type Locks struct {
+	mu    sync.Mutex
+	locks map[string]*sync.Mutex
+}
+
+// NewLocks returns Locks holding no lock.
+func NewLocks() *Locks {
+	return &Locks{locks: map[string]*sync.Mutex{}}
+}
+
+// lockFor returns the lock of a RestAPI. The locks are kept for the lifetime
+// of the controller, like the resource managers.
+func (l *Locks) lockFor(key string) *sync.Mutex {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	lock, ok := l.locks[key]
+	if !ok {
+		lock = &sync.Mutex{}
+		l.locks[key] = lock
+	}
+	return lock
+}
+
+// middlewareFor returns the function adding to the middleware stack of the
+// API Gateway client of an account and region the middleware holding the
+// lock of the RestAPI of each mutating call while it is sent, retries
+// included, and classifying the conflicts of the mutating calls, terminal
+// unless they are concurrent modifications when terminalConflicts is set.
+func (l *Locks) middlewareFor(
+	id ackv1alpha1.AWSAccountID,
+	region ackv1alpha1.AWSRegion,
+	terminalConflicts bool,
+) func(*middleware.Stack) error {
+	return func(stack *middleware.Stack) error {
+		serialize := middleware.InitializeMiddlewareFunc("RestAPILock", func(
+			ctx context.Context,
+			in middleware.InitializeInput,
+			next middleware.InitializeHandler,
+		) (middleware.InitializeOutput, middleware.Metadata, error) {
+			if util.IsReadOperation(awsmiddleware.GetOperationName(ctx)) {
+				return next.HandleInitialize(ctx, in)
+			}
+			if restAPIID := util.RestAPIID(in.Parameters); restAPIID != "" {
+				lock := l.lockFor(string(id) + "/" + string(region) + "/" + restAPIID)
+				lock.Lock()
+				defer lock.Unlock()
+			}
+			out, md, err := next.HandleInitialize(ctx, in)
+			return out, md, conflictError(err, terminalConflicts)
+		})
+		return stack.Initialize.Add(serialize, middleware.After)
+	}
+}
+
+// WrapManagerFactories returns resource manager factories whose managers
+// serialize their mutating calls with those of the other managers on the
+// same RestAPI.
+func WrapManagerFactories(
+	factories []acktypes.AWSResourceManagerFactory,
+	locks *Locks,
+) []acktypes.AWSResourceManagerFactory {
+	wrapped := make([]acktypes.AWSResourceManagerFactory, 0, len(factories))
+	for _, f := range factories {
+		wrapped = append(wrapped, &managerFactory{AWSResourceManagerFactory: f, locks: locks})
+	}
+	return wrapped
+}
+
+type managerFactory struct {
+	acktypes.AWSResourceManagerFactory
+	locks *Locks
+}
+
+// ManagerFor returns a resource manager whose API Gateway client holds the
+// lock of the RestAPI of each mutating call.
+func (f *managerFactory) ManagerFor(
+	cfg ackcfg.Config,
+	clientcfg aws.Config,
+	log logr.Logger,
+	metrics *ackmetrics.Metrics,
+	rr acktypes.Reconciler,
+	id ackv1alpha1.AWSAccountID,
+	region ackv1alpha1.AWSRegion,
+	roleARN ackv1alpha1.AWSResourceName,
+) (acktypes.AWSResourceManager, error) {
+	terminalConflicts := terminalConflictKinds[f.ResourceDescriptor().GroupVersionKind().Kind]
+	clientcfg.APIOptions = append(slices.Clone(clientcfg.APIOptions), f.locks.middlewareFor(id, region, terminalConflicts))
+	return f.AWSResourceManagerFactory.ManagerFor(cfg, clientcfg, log, metrics, rr, id, region, roleARN)
+}
@@ -0,0 +1,206 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package restapilock_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	ackcondition "github.com/aws-controllers-k8s/runtime/pkg/condition"
+	ackcfg "github.com/aws-controllers-k8s/runtime/pkg/config"
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	ackmetrics "github.com/aws-controllers-k8s/runtime/pkg/metrics"
+	ackrequeue "github.com/aws-controllers-k8s/runtime/pkg/requeue"
+	acktypes "github.com/aws-controllers-k8s/runtime/pkg/types"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	smithy "github.com/aws/smithy-go"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	svcapitypes "github.com/aws-controllers-k8s/apigateway-controller/apis/v1alpha1"
+	svcresource "github.com/aws-controllers-k8s/apigateway-controller/pkg/resource"
+	"github.com/aws-controllers-k8s/apigateway-controller/pkg/restapilock"
+
+	_ "github.com/aws-controllers-k8s/apigateway-controller/pkg/resource/deployment"
+	_ "github.com/aws-controllers-k8s/apigateway-controller/pkg/resource/rest_api"
+)
+
+// transport answers every request after a delay, with a ConflictException
+// of message conflict if set and with an empty API Gateway response
+// otherwise. It records the highest number of requests in flight.
+type transport struct {
+	conflict string
+	delay    time.Duration
+
+	mu          sync.Mutex
+	inFlight    int
+	maxInFlight int
+}
+
+func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.mu.Lock()
+	t.inFlight++
+	t.maxInFlight = max(t.maxInFlight, t.inFlight)
+	t.mu.Unlock()
+	time.Sleep(t.delay)
+	t.mu.Lock()
+	t.inFlight--
+	t.mu.Unlock()
+
+	if t.conflict != "" {
+		return &http.Response{
+			StatusCode: http.StatusConflict,
+			Header: http.Header{
+				"Content-Type":     []string{"application/json"},
+				"X-Amzn-Errortype": []string{"ConflictException"},
+			},
+			Body:    io.NopCloser(strings.NewReader(fmt.Sprintf(`{"message":%q}`, t.conflict))),
+			Request: req,
+		}, nil
+	}
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       io.NopCloser(strings.NewReader("{}")),
+		Request:    req,
+	}, nil
+}
+
+var accounts int
+
+// manager returns the resource manager of a kind, whose client sends the
+// requests with tr.
+func manager(t *testing.T, kind string, tr *transport) (acktypes.AWSResourceManager, acktypes.AWSResourceDescriptor) {
+	t.Helper()
+	var factory acktypes.AWSResourceManagerFactory
+	for _, f := range restapilock.WrapManagerFactories(svcresource.GetManagerFactories(), restapilock.NewLocks()) {
+		if f.ResourceDescriptor().GroupVersionKind().Kind == kind {
+			factory = f
+		}
+	}
+	require.NotNil(t, factory)
+
+	clientcfg := aws.Config{
+		Region:      "us-west-2",
+		Credentials: credentials.NewStaticCredentialsProvider("AKID", "SECRET", ""),
+		HTTPClient:  &http.Client{Transport: tr},
+	}
+	// The resource managers are cached by account, so that every manager
+	// gets its own client.
+	accounts++
+	rm, err := factory.ManagerFor(ackcfg.Config{}, clientcfg, logr.Discard(), ackmetrics.NewMetrics("apigateway"),
+		nil, ackv1alpha1.AWSAccountID(fmt.Sprintf("%012d", accounts)), "us-west-2", "")
+	require.NoError(t, err)
+	return rm, factory.ResourceDescriptor()
+}
+
+func restAPI(id, description string) *svcapitypes.RestAPI {
+	return &svcapitypes.RestAPI{
+		ObjectMeta: metav1.ObjectMeta{Name: id, Namespace: "default"},
+		Spec: svcapitypes.RestAPISpec{
+			Name:        aws.String(id),
+			Description: aws.String(description),
+		},
+		Status: svcapitypes.RestAPIStatus{ID: aws.String(id)},
+	}
+}
+
+// updateAll updates concurrently the description of the RestAPIs of ids.
+func updateAll(rm acktypes.AWSResourceManager, rd acktypes.AWSResourceDescriptor, ids ...string) {
+	var wg sync.WaitGroup
+	for _, id := range ids {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			desired := rd.ResourceFromRuntimeObject(restAPI(id, "new"))
+			latest := rd.ResourceFromRuntimeObject(restAPI(id, "old"))
+			_, _ = rm.Update(context.Background(), desired, latest, rd.Delta(desired, latest))
+		}()
+	}
+	wg.Wait()
+}
+
+func TestUpdate_SerializedPerRestAPI(t *testing.T) {
+	tr := &transport{delay: 50 * time.Millisecond}
+	rm, rd := manager(t, "RestAPI", tr)
+	updateAll(rm, rd, "api1", "api1", "api1")
+	assert.Equal(t, 1, tr.maxInFlight)
+
+	tr = &transport{delay: 50 * time.Millisecond}
+	rm, rd = manager(t, "RestAPI", tr)
+	updateAll(rm, rd, "api1", "api2")
+	assert.Equal(t, 2, tr.maxInFlight, "the calls on different RestAPIs are not serialized")
+}
+
+func TestUpdate_ConcurrentModification(t *testing.T) {
+	tr := &transport{conflict: "Unable to complete operation due to concurrent modification. Please try again later."}
+	rm, rd := manager(t, "RestAPI", tr)
+	desired := rd.ResourceFromRuntimeObject(restAPI("api1", "new"))
+	latest := rd.ResourceFromRuntimeObject(restAPI("api1", "old"))
+
+	updated, err := rm.Update(context.Background(), desired, latest, rd.Delta(desired, latest))
+	require.Error(t, err)
+	var requeue *ackrequeue.RequeueNeededAfter
+	require.True(t, errors.As(err, &requeue))
+	assert.GreaterOrEqual(t, requeue.Duration(), restapilock.RequeueAfter)
+	assert.Less(t, requeue.Duration(), restapilock.RequeueAfter+restapilock.RequeueJitter)
+	var concurrent *restapilock.ConcurrentModificationError
+	assert.True(t, errors.As(err, &concurrent))
+	var apiErr smithy.APIError
+	require.True(t, errors.As(err, &apiErr))
+	assert.Equal(t, "ConflictException", apiErr.ErrorCode())
+
+	assert.Nil(t, ackcondition.Terminal(updated))
+	assert.NotNil(t, ackcondition.Recoverable(updated))
+}
+
+func TestUpdate_Conflict(t *testing.T) {
+	tr := &transport{conflict: "Stage already exists"}
+	rm, rd := manager(t, "RestAPI", tr)
+	desired := rd.ResourceFromRuntimeObject(restAPI("api1", "new"))
+	latest := rd.ResourceFromRuntimeObject(restAPI("api1", "old"))
+
+	updated, err := rm.Update(context.Background(), desired, latest, rd.Delta(desired, latest))
+	require.Error(t, err)
+	var requeue *ackrequeue.RequeueNeededAfter
+	assert.False(t, errors.As(err, &requeue))
+	assert.Equal(t, ackerr.Terminal, err)
+	assert.NotNil(t, ackcondition.Terminal(updated), "conflicts with the state of the resources stay terminal")
+}
+
+func TestDelete_ConflictRetried(t *testing.T) {
+	tr := &transport{conflict: "Active stages pointing to this deployment must be moved or deleted"}
+	rm, rd := manager(t, "Deployment", tr)
+	r := rd.ResourceFromRuntimeObject(&svcapitypes.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "deployment", Namespace: "default"},
+		Spec:       svcapitypes.DeploymentSpec{RestAPIID: aws.String("api1")},
+		Status:     svcapitypes.DeploymentStatus{ID: aws.String("deployment1")},
+	})
+
+	deleted, err := rm.Delete(context.Background(), r)
+	require.Error(t, err)
+	assert.NotEqual(t, ackerr.Terminal, err)
+	assert.Nil(t, ackcondition.Terminal(deleted), "the conflicts of a Deployment are retried")
+}
@@ -0,0 +1,339 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+// Package treecache serves the reads of the resources, methods, integrations
+// and method and integration responses of a RestAPI from a short-lived cache
+// of its resource tree, read with paginated GetResources calls embedding the
+// methods. The reconciles of the Resources, Methods, Integrations and
+// responses of a RestAPI share the cache, instead of each reading its own
+// resource, and any mutating call on the RestAPI drops it.
+package treecache
+
+import (
+	"context"
+	"reflect"
+	"slices"
+	"sync"
+	"time"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	ackcfg "github.com/aws-controllers-k8s/runtime/pkg/config"
+	ackmetrics "github.com/aws-controllers-k8s/runtime/pkg/metrics"
+	acktypes "github.com/aws-controllers-k8s/runtime/pkg/types"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	svcsdk "github.com/aws/aws-sdk-go-v2/service/apigateway"
+	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/apigateway/types"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/go-logr/logr"
+
+	"github.com/aws-controllers-k8s/apigateway-controller/pkg/util"
+)
+
+// pageSize is the number of resources read by each GetResources call, the
+// maximum API Gateway allows.
+const pageSize = 500
+
+// Caches holds the resource trees of the RestAPIs read by the resource
+// managers.
+type Caches struct {
+	ttl   time.Duration
+	mu    sync.Mutex
+	trees map[string]*tree
+}
+
+// NewCaches returns Caches keeping the resource tree of a RestAPI for ttl.
+func NewCaches(ttl time.Duration) *Caches {
+	return &Caches{ttl: ttl, trees: map[string]*tree{}}
+}
+
+// tree is the resource tree of a RestAPI, read once by the first call
+// needing it.
+type tree struct {
+	read      time.Time
+	mu        sync.Mutex
+	loaded    bool
+	resources map[string]svcsdktypes.Resource
+}
+
+// treeFor returns the cached tree of a RestAPI, or a new empty one if it is
+// not cached or has expired. Caching a new tree evicts the expired trees of
+// the other RestAPIs too, so that the trees of deleted or idle RestAPIs are
+// not kept.
+func (c *Caches) treeFor(key string) *tree {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if t, ok := c.trees[key]; ok && !c.expired(t) {
+		return t
+	}
+	for k, t := range c.trees {
+		if c.expired(t) {
+			delete(c.trees, k)
+		}
+	}
+	t := &tree{read: time.Now()}
+	c.trees[key] = t
+	return t
+}
+
+// expired returns whether a tree was read more than ttl ago.
+func (c *Caches) expired(t *tree) bool {
+	return time.Since(t.read) > c.ttl
+}
+
+// Len returns the number of resource trees cached.
+func (c *Caches) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.trees)
+}
+
+// drop removes the tree of a RestAPI from the cache. Calls already holding
+// the tree keep reading it.
+func (c *Caches) drop(key string, t *tree) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if t == nil || c.trees[key] == t {
+		delete(c.trees, key)
+	}
+}
+
+// resources returns the resources of a RestAPI by ID, with their methods
+// embedded, reading them unless cached.
+func (c *Caches) resources(
+	ctx context.Context,
+	client *svcsdk.Client,
+	metrics *ackmetrics.Metrics,
+	key string,
+	restAPIID string,
+) (map[string]svcsdktypes.Resource, error) {
+	t := c.treeFor(key)
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.loaded {
+		return t.resources, nil
+	}
+	resources := map[string]svcsdktypes.Resource{}
+	pages := svcsdk.NewGetResourcesPaginator(client, &svcsdk.GetResourcesInput{
+		RestApiId: aws.String(restAPIID),
+		Embed:     []string{"methods"},
+		Limit:     aws.Int32(pageSize),
+	})
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+		metrics.RecordAPICall("READ_MANY", "GetResources", err)
+		if err != nil {
+			c.drop(key, t)
+			return nil, err
+		}
+		for _, res := range page.Items {
+			resources[aws.ToString(res.Id)] = res
+		}
+	}
+	t.resources = resources
+	t.loaded = true
+	return resources, nil
+}
+
+// middlewareFor returns the function adding to the middleware stack of the
+// API Gateway client of an account and region the middleware answering the
+// reads of the resource tree of a RestAPI from the cache, and dropping the
+// cache on the mutating calls. The resource tree is read with client.
+func (c *Caches) middlewareFor(
+	client *svcsdk.Client,
+	metrics *ackmetrics.Metrics,
+	id ackv1alpha1.AWSAccountID,
+	region ackv1alpha1.AWSRegion,
+) func(*middleware.Stack) error {
+	return func(stack *middleware.Stack) error {
+		serve := middleware.InitializeMiddlewareFunc("TreeCache", func(
+			ctx context.Context,
+			in middleware.InitializeInput,
+			next middleware.InitializeHandler,
+		) (middleware.InitializeOutput, middleware.Metadata, error) {
+			restAPIID := util.RestAPIID(in.Parameters)
+			if restAPIID == "" {
+				return next.HandleInitialize(ctx, in)
+			}
+			key := string(id) + "/" + string(region) + "/" + restAPIID
+			if !util.IsReadOperation(awsmiddleware.GetOperationName(ctx)) {
+				out, md, err := next.HandleInitialize(ctx, in)
+				c.drop(key, nil)
+				return out, md, err
+			}
+			if !served(in.Parameters) {
+				return next.HandleInitialize(ctx, in)
+			}
+			resources, err := c.resources(ctx, client, metrics, key, restAPIID)
+			if err != nil {
+				// The call itself reports why the tree cannot be read, e.g.
+				// a RestAPI not found.
+				return next.HandleInitialize(ctx, in)
+			}
+			result, err := answer(in.Parameters, resources)
+			return middleware.InitializeOutput{Result: result}, middleware.Metadata{}, err
+		})
+		return stack.Initialize.Add(serve, middleware.After)
+	}
+}
+
+// served returns whether the call of input is answered from the cache.
+func served(input interface{}) bool {
+	switch input.(type) {
+	case *svcsdk.GetResourceInput,
+		*svcsdk.GetMethodInput,
+		*svcsdk.GetIntegrationInput,
+		*svcsdk.GetMethodResponseInput,
+		*svcsdk.GetIntegrationResponseInput:
+		return true
+	}
+	return false
+}
+
+// answer returns the output of the call of input, read from the resources of
+// its RestAPI, or the NotFoundException API Gateway would return.
+func answer(input interface{}, resources map[string]svcsdktypes.Resource) (interface{}, error) {
+	switch in := input.(type) {
+	case *svcsdk.GetResourceInput:
+		res, ok := resources[aws.ToString(in.ResourceId)]
+		if !ok {
+			return nil, notFound("Invalid Resource identifier specified")
+		}
+		return copyFields(&svcsdk.GetResourceOutput{}, &res), nil
+	case *svcsdk.GetMethodInput:
+		method, err := findMethod(resources, in.ResourceId, in.HttpMethod)
+		if err != nil {
+			return nil, err
+		}
+		return copyFields(&svcsdk.GetMethodOutput{}, &method), nil
+	case *svcsdk.GetIntegrationInput:
+		integration, err := findIntegration(resources, in.ResourceId, in.HttpMethod)
+		if err != nil {
+			return nil, err
+		}
+		return copyFields(&svcsdk.GetIntegrationOutput{}, integration), nil
+	case *svcsdk.GetMethodResponseInput:
+		method, err := findMethod(resources, in.ResourceId, in.HttpMethod)
+		if err != nil {
+			return nil, err
+		}
+		response, ok := method.MethodResponses[aws.ToString(in.StatusCode)]
+		if !ok {
+			return nil, notFound("Invalid Response status code specified")
+		}
+		return copyFields(&svcsdk.GetMethodResponseOutput{}, &response), nil
+	case *svcsdk.GetIntegrationResponseInput:
+		integration, err := findIntegration(resources, in.ResourceId, in.HttpMethod)
+		if err != nil {
+			return nil, err
+		}
+		response, ok := integration.IntegrationResponses[aws.ToString(in.StatusCode)]
+		if !ok {
+			return nil, notFound("Invalid Response status code specified")
+		}
+		return copyFields(&svcsdk.GetIntegrationResponseOutput{}, &response), nil
+	}
+	return nil, nil
+}
+
+func findMethod(
+	resources map[string]svcsdktypes.Resource,
+	resourceID *string,
+	httpMethod *string,
+) (svcsdktypes.Method, error) {
+	res, ok := resources[aws.ToString(resourceID)]
+	if !ok {
+		return svcsdktypes.Method{}, notFound("Invalid Resource identifier specified")
+	}
+	method, ok := res.ResourceMethods[aws.ToString(httpMethod)]
+	if !ok {
+		return svcsdktypes.Method{}, notFound("Invalid Method identifier specified")
+	}
+	return method, nil
+}
+
+func findIntegration(
+	resources map[string]svcsdktypes.Resource,
+	resourceID *string,
+	httpMethod *string,
+) (*svcsdktypes.Integration, error) {
+	method, err := findMethod(resources, resourceID, httpMethod)
+	if err != nil {
+		return nil, err
+	}
+	if method.MethodIntegration == nil {
+		return nil, notFound("Invalid Integration identifier specified")
+	}
+	return method.MethodIntegration, nil
+}
+
+func notFound(message string) error {
+	return &svcsdktypes.NotFoundException{Message: aws.String(message)}
+}
+
+// copyFields sets the exported fields of the struct dst points to from the
+// fields of the same name of the struct src points to, and returns dst. The
+// outputs of the Get calls have the fields of the embedded types they
+// return, which they cannot be converted from.
+func copyFields[T any](dst *T, src interface{}) *T {
+	d := reflect.ValueOf(dst).Elem()
+	s := reflect.ValueOf(src).Elem()
+	for i := 0; i < d.NumField(); i++ {
+		field := d.Type().Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		v := s.FieldByName(field.Name)
+		if v.IsValid() && v.Type() == field.Type {
+			d.Field(i).Set(v)
+		}
+	}
+	return dst
+}
+
+// WrapManagerFactories returns resource manager factories whose managers
+// read the resource trees of the RestAPIs through caches.
+func WrapManagerFactories(
+	factories []acktypes.AWSResourceManagerFactory,
+	caches *Caches,
+) []acktypes.AWSResourceManagerFactory {
+	wrapped := make([]acktypes.AWSResourceManagerFactory, 0, len(factories))
+	for _, f := range factories {
+		wrapped = append(wrapped, &managerFactory{AWSResourceManagerFactory: f, caches: caches})
+	}
+	return wrapped
+}
+
+type managerFactory struct {
+	acktypes.AWSResourceManagerFactory
+	caches *Caches
+}
+
+// ManagerFor returns a resource manager whose API Gateway client answers the
+// reads of the resource tree of a RestAPI from the cache. The tree is read
+// with a client sharing the middlewares of the resource managers, e.g. their
+// rate limits.
+func (f *managerFactory) ManagerFor(
+	cfg ackcfg.Config,
+	clientcfg aws.Config,
+	log logr.Logger,
+	metrics *ackmetrics.Metrics,
+	rr acktypes.Reconciler,
+	id ackv1alpha1.AWSAccountID,
+	region ackv1alpha1.AWSRegion,
+	roleARN ackv1alpha1.AWSResourceName,
+) (acktypes.AWSResourceManager, error) {
+	client := svcsdk.NewFromConfig(clientcfg)
+	clientcfg.APIOptions = append(slices.Clone(clientcfg.APIOptions), f.caches.middlewareFor(client, metrics, id, region))
+	return f.AWSResourceManagerFactory.ManagerFor(cfg, clientcfg, log, metrics, rr, id, region, roleARN)
+}
@@ -0,0 +1,216 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package treecache_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	ackcfg "github.com/aws-controllers-k8s/runtime/pkg/config"
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	ackmetrics "github.com/aws-controllers-k8s/runtime/pkg/metrics"
+	acktypes "github.com/aws-controllers-k8s/runtime/pkg/types"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	svcapitypes "github.com/aws-controllers-k8s/apigateway-controller/apis/v1alpha1"
+	svcresource "github.com/aws-controllers-k8s/apigateway-controller/pkg/resource"
+	"github.com/aws-controllers-k8s/apigateway-controller/pkg/treecache"
+
+	_ "github.com/aws-controllers-k8s/apigateway-controller/pkg/resource/api_method_response"
+	_ "github.com/aws-controllers-k8s/apigateway-controller/pkg/resource/integration"
+	_ "github.com/aws-controllers-k8s/apigateway-controller/pkg/resource/method"
+	_ "github.com/aws-controllers-k8s/apigateway-controller/pkg/resource/resource"
+)
+
+// pages are the pages of the resources of the RestAPI api1, by position.
+var pages = map[string]string{
+	"": `{"position":"p2","item":[{"id":"root","path":"/"}]}`,
+	"p2": `{"item":[{"id":"r1","parentId":"root","path":"/pets","pathPart":"pets","resourceMethods":{
+		"GET":{"httpMethod":"GET","authorizationType":"NONE",
+			"methodResponses":{"200":{"statusCode":"200"}},
+			"methodIntegration":{"type":"MOCK","integrationResponses":{"200":{"statusCode":"200"}}}}}}]}`,
+}
+
+// transport answers the GetResources calls of api1 with pages, and any other
+// call with an empty API Gateway response. It records the requests sent.
+type transport struct {
+	mu   sync.Mutex
+	sent []string
+}
+
+func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.mu.Lock()
+	t.sent = append(t.sent, req.Method+" "+req.URL.Path)
+	t.mu.Unlock()
+	body := "{}"
+	if req.Method == http.MethodGet && req.URL.Path == "/restapis/api1/resources" {
+		body = pages[req.URL.Query().Get("position")]
+	}
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       io.NopCloser(strings.NewReader(body)),
+		Request:    req,
+	}, nil
+}
+
+var accounts int
+
+// managers returns the resource managers of an account by kind, reading the
+// resource trees through caches keeping them for ttl.
+func managers(t *testing.T, ttl time.Duration) (map[string]acktypes.AWSResourceManager, map[string]acktypes.AWSResourceDescriptor, *transport) {
+	t.Helper()
+	return cachedManagers(t, treecache.NewCaches(ttl))
+}
+
+// cachedManagers returns the resource managers of an account by kind,
+// reading the resource trees through caches.
+func cachedManagers(t *testing.T, caches *treecache.Caches) (map[string]acktypes.AWSResourceManager, map[string]acktypes.AWSResourceDescriptor, *transport) {
+	t.Helper()
+	tr := &transport{}
+	clientcfg := aws.Config{
+		Region:      "us-west-2",
+		Credentials: credentials.NewStaticCredentialsProvider("AKID", "SECRET", ""),
+		HTTPClient:  &http.Client{Transport: tr},
+	}
+	// The resource managers are cached by account, so that every test gets
+	// its own clients.
+	accounts++
+	rms := map[string]acktypes.AWSResourceManager{}
+	rds := map[string]acktypes.AWSResourceDescriptor{}
+	for _, f := range treecache.WrapManagerFactories(svcresource.GetManagerFactories(), caches) {
+		kind := f.ResourceDescriptor().GroupVersionKind().Kind
+		rm, err := f.ManagerFor(ackcfg.Config{}, clientcfg, logr.Discard(), ackmetrics.NewMetrics("apigateway"),
+			nil, ackv1alpha1.AWSAccountID(fmt.Sprintf("%012d", accounts)), "us-west-2", "")
+		require.NoError(t, err)
+		rms[kind] = rm
+		rds[kind] = f.ResourceDescriptor()
+	}
+	return rms, rds, tr
+}
+
+func readOne(
+	t *testing.T,
+	rms map[string]acktypes.AWSResourceManager,
+	rds map[string]acktypes.AWSResourceDescriptor,
+	kind string,
+	obj client.Object,
+) (acktypes.AWSResource, error) {
+	t.Helper()
+	return rms[kind].ReadOne(context.Background(), rds[kind].ResourceFromRuntimeObject(obj))
+}
+
+func method(httpMethod string) *svcapitypes.Method {
+	return &svcapitypes.Method{Spec: svcapitypes.MethodSpec{
+		RestAPIID:  aws.String("api1"),
+		ResourceID: aws.String("r1"),
+		HTTPMethod: aws.String(httpMethod),
+	}}
+}
+
+func TestReadOne_FromCache(t *testing.T) {
+	rms, rds, tr := managers(t, time.Minute)
+
+	latest, err := readOne(t, rms, rds, "Method", method("GET"))
+	require.NoError(t, err)
+	assert.Equal(t, "NONE", *latest.RuntimeObject().(*svcapitypes.Method).Spec.AuthorizationType)
+
+	latest, err = readOne(t, rms, rds, "Integration", &svcapitypes.Integration{Spec: svcapitypes.IntegrationSpec{
+		RestAPIID:  aws.String("api1"),
+		ResourceID: aws.String("r1"),
+		HTTPMethod: aws.String("GET"),
+	}})
+	require.NoError(t, err)
+	assert.Equal(t, "MOCK", *latest.RuntimeObject().(*svcapitypes.Integration).Spec.Type)
+
+	_, err = readOne(t, rms, rds, "APIMethodResponse", &svcapitypes.APIMethodResponse{Spec: svcapitypes.APIMethodResponseSpec{
+		RestAPIID:  aws.String("api1"),
+		ResourceID: aws.String("r1"),
+		HTTPMethod: aws.String("GET"),
+		StatusCode: aws.String("200"),
+	}})
+	require.NoError(t, err)
+
+	latest, err = readOne(t, rms, rds, "Resource", &svcapitypes.Resource{
+		Spec:   svcapitypes.ResourceSpec{RestAPIID: aws.String("api1")},
+		Status: svcapitypes.ResourceStatus{ID: aws.String("r1")},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "/pets", *latest.RuntimeObject().(*svcapitypes.Resource).Status.Path)
+
+	_, err = readOne(t, rms, rds, "Method", method("DELETE"))
+	assert.Equal(t, ackerr.NotFound, err)
+
+	assert.Equal(t, []string{"GET /restapis/api1/resources", "GET /restapis/api1/resources"}, tr.sent,
+		"the tree is read once, in two pages")
+}
+
+func TestReadOne_DroppedOnMutation(t *testing.T) {
+	rms, rds, tr := managers(t, time.Minute)
+
+	_, err := readOne(t, rms, rds, "Method", method("GET"))
+	require.NoError(t, err)
+	_, err = rms["Method"].Delete(context.Background(), rds["Method"].ResourceFromRuntimeObject(method("GET")))
+	require.NoError(t, err)
+	_, err = readOne(t, rms, rds, "Method", method("GET"))
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{
+		"GET /restapis/api1/resources",
+		"GET /restapis/api1/resources",
+		"DELETE /restapis/api1/resources/r1/methods/GET",
+		"GET /restapis/api1/resources",
+		"GET /restapis/api1/resources",
+	}, tr.sent)
+}
+
+func TestReadOne_Expired(t *testing.T) {
+	rms, rds, tr := managers(t, time.Nanosecond)
+
+	_, err := readOne(t, rms, rds, "Method", method("GET"))
+	require.NoError(t, err)
+	time.Sleep(time.Millisecond)
+	_, err = readOne(t, rms, rds, "Method", method("GET"))
+	require.NoError(t, err)
+
+	assert.Len(t, tr.sent, 4)
+}
+
+func TestReadOne_ExpiredEvicted(t *testing.T) {
+	caches := treecache.NewCaches(50 * time.Millisecond)
+	rms, rds, _ := cachedManagers(t, caches)
+
+	_, err := readOne(t, rms, rds, "Method", method("GET"))
+	require.NoError(t, err)
+	assert.Equal(t, 1, caches.Len())
+	time.Sleep(60 * time.Millisecond)
+
+	// Reading another RestAPI evicts the expired tree of the first one.
+	other := method("GET")
+	other.Spec.RestAPIID = aws.String("api2")
+	_, _ = readOne(t, rms, rds, "Method", other)
+	assert.Equal(t, 1, caches.Len())
+}
@@ -0,0 +1,330 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+// Package usage reports the daily usage of the API keys managed by the
+// controller, as read from the GetUsage API of their usage plans, as
+// Prometheus metrics labelled with the namespace and name of the APIKeys.
+// The usage is read with the clients of the resource managers of the APIKeys,
+// so in the accounts and regions and with the roles, rate limits and metrics
+// the service controller reconciles them with.
+package usage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	ackcfg "github.com/aws-controllers-k8s/runtime/pkg/config"
+	ackmetrics "github.com/aws-controllers-k8s/runtime/pkg/metrics"
+	acktypes "github.com/aws-controllers-k8s/runtime/pkg/types"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	svcsdk "github.com/aws/aws-sdk-go-v2/service/apigateway"
+	"github.com/go-logr/logr"
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	svcapitypes "github.com/aws-controllers-k8s/apigateway-controller/apis/v1alpha1"
+)
+
+// metricLabels are the labels of the usage metrics: the APIKey and the usage
+// plan the usage is counted in.
+var metricLabels = []string{"namespace", "name", "usage_plan_id"}
+
+// Reporter periodically sets the usage metrics of the APIKeys in the
+// namespaces watched by the controller, for the current UTC day. The usage is
+// read once per account and region, with the client of a resource manager of
+// the account and region, once the resource managers returned by
+// WrapManagerFactories have read the APIKeys.
+type Reporter struct {
+	kc       client.Reader
+	interval time.Duration
+	log      logr.Logger
+
+	used      *prometheus.GaugeVec
+	remaining *prometheus.GaugeVec
+
+	mu sync.Mutex
+	// clients are the clients of the resource managers of each account and
+	// region.
+	clients map[target]targetClient
+	// targets are the accounts and regions of the APIKeys read by the
+	// resource managers.
+	targets map[types.NamespacedName]target
+
+	// last are the samples of the last report of each account and region.
+	last map[target][]sample
+}
+
+// NewReporter returns a Reporter reading the usage every interval, and
+// registers its metrics.
+func NewReporter(
+	kc client.Reader,
+	interval time.Duration,
+	log logr.Logger,
+	registerer prometheus.Registerer,
+) (*Reporter, error) {
+	r := &Reporter{
+		kc:       kc,
+		interval: interval,
+		log:      log,
+		used: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "apigateway_api_key_usage_requests",
+			Help: "Number of requests made with an API key in a usage plan during the current UTC day.",
+		}, metricLabels),
+		remaining: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "apigateway_api_key_usage_remaining_quota",
+			Help: "Number of requests an API key can still make in the current quota period of a usage plan with a quota.",
+		}, metricLabels),
+		clients: map[target]targetClient{},
+		targets: map[types.NamespacedName]target{},
+		last:    map[target][]sample{},
+	}
+	for _, c := range []prometheus.Collector{r.used, r.remaining} {
+		if err := registerer.Register(c); err != nil {
+			return nil, err
+		}
+	}
+	return r, nil
+}
+
+// NeedLeaderElection returns true: only the leader reads the usage.
+func (r *Reporter) NeedLeaderElection() bool {
+	return true
+}
+
+// Start reports the usage every interval until the context is done.
+func (r *Reporter) Start(ctx context.Context) error {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+	for {
+		if err := r.Report(ctx); err != nil {
+			r.log.Error(err, "unable to report API key usage")
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// WrapManagerFactories returns resource manager factories whose APIKey
+// managers record the accounts and regions of the APIKeys they read, and
+// their clients, for the Reporter r. The factories must be wrapped by the
+// ones adding middleware to the clients, such as the rate limits, so that the
+// usage is read through the same middleware.
+func WrapManagerFactories(
+	factories []acktypes.AWSResourceManagerFactory,
+	r *Reporter,
+) []acktypes.AWSResourceManagerFactory {
+	wrapped := make([]acktypes.AWSResourceManagerFactory, 0, len(factories))
+	for _, f := range factories {
+		if f.ResourceDescriptor().GroupVersionKind().Kind != "APIKey" {
+			wrapped = append(wrapped, f)
+			continue
+		}
+		wrapped = append(wrapped, &managerFactory{AWSResourceManagerFactory: f, reporter: r})
+	}
+	return wrapped
+}
+
+type managerFactory struct {
+	acktypes.AWSResourceManagerFactory
+	reporter *Reporter
+}
+
+// ManagerFor returns an APIKey resource manager recording the account and
+// region of the APIKeys it reads.
+func (f *managerFactory) ManagerFor(
+	cfg ackcfg.Config,
+	clientcfg aws.Config,
+	log logr.Logger,
+	metrics *ackmetrics.Metrics,
+	rr acktypes.Reconciler,
+	id ackv1alpha1.AWSAccountID,
+	region ackv1alpha1.AWSRegion,
+	roleARN ackv1alpha1.AWSResourceName,
+) (acktypes.AWSResourceManager, error) {
+	rm, err := f.AWSResourceManagerFactory.ManagerFor(cfg, clientcfg, log, metrics, rr, id, region, roleARN)
+	if err != nil {
+		return nil, err
+	}
+	t := target{account: id, region: region}
+	f.reporter.setClient(t, targetClient{sdkapi: svcsdk.NewFromConfig(clientcfg), metrics: metrics})
+	return &resourceManager{AWSResourceManager: rm, reporter: f.reporter, target: t}, nil
+}
+
+type resourceManager struct {
+	acktypes.AWSResourceManager
+	reporter *Reporter
+	target   target
+}
+
+// ReadOne records the account and region of the APIKey and reads it.
+func (rm *resourceManager) ReadOne(
+	ctx context.Context,
+	res acktypes.AWSResource,
+) (acktypes.AWSResource, error) {
+	meta := res.MetaObject()
+	rm.reporter.setTarget(types.NamespacedName{Namespace: meta.GetNamespace(), Name: meta.GetName()}, rm.target)
+	return rm.AWSResourceManager.ReadOne(ctx, res)
+}
+
+func (r *Reporter) setClient(t target, c targetClient) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.clients[t] = c
+}
+
+func (r *Reporter) setTarget(key types.NamespacedName, t target) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.targets[key] = t
+}
+
+// sample is the usage of an API key in a usage plan.
+type sample struct {
+	labels    prometheus.Labels
+	used      float64
+	remaining *float64
+}
+
+// target is an account and region whose usage is read at once.
+type target struct {
+	account ackv1alpha1.AWSAccountID
+	region  ackv1alpha1.AWSRegion
+}
+
+// targetClient is the client of a resource manager of an account and region,
+// and the metrics of its calls.
+type targetClient struct {
+	sdkapi  *svcsdk.Client
+	metrics *ackmetrics.Metrics
+}
+
+// Report sets the usage metrics of the APIKeys. The APIKeys that were not
+// read by a resource manager yet are left out. The metrics of an account and
+// region are left as they are when its usage cannot be read, rather than
+// dropping its keys, and the metrics of the other ones are still set.
+func (r *Reporter) Report(ctx context.Context) error {
+	list := &svcapitypes.APIKeyList{}
+	if err := r.kc.List(ctx, list); err != nil {
+		return err
+	}
+	keys := map[target]map[string]types.NamespacedName{}
+	clients := map[target]targetClient{}
+	listed := map[types.NamespacedName]bool{}
+	r.mu.Lock()
+	for i := range list.Items {
+		ko := &list.Items[i]
+		key := types.NamespacedName{Namespace: ko.Namespace, Name: ko.Name}
+		listed[key] = true
+		t, ok := r.targets[key]
+		if ko.Status.ID == nil || !ok {
+			continue
+		}
+		if keys[t] == nil {
+			keys[t] = map[string]types.NamespacedName{}
+			clients[t] = r.clients[t]
+		}
+		keys[t][*ko.Status.ID] = key
+	}
+	for key := range r.targets {
+		if !listed[key] {
+			delete(r.targets, key)
+		}
+	}
+	r.mu.Unlock()
+
+	var errs []error
+	day := time.Now().UTC().Format("2006-01-02")
+	last := map[target][]sample{}
+	for t, ids := range keys {
+		s, err := targetUsage(ctx, clients[t], ids, day)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("account %s in %s: %w", t.account, t.region, err))
+			s = r.last[t]
+		}
+		last[t] = s
+	}
+	r.last = last
+
+	r.used.Reset()
+	r.remaining.Reset()
+	for _, samples := range last {
+		for _, s := range samples {
+			r.used.With(s.labels).Set(s.used)
+			if s.remaining != nil {
+				r.remaining.With(s.labels).Set(*s.remaining)
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// targetUsage returns the usage during day of the API keys ids of an account
+// and region in each of the usage plans of the account and region.
+func targetUsage(
+	ctx context.Context,
+	c targetClient,
+	ids map[string]types.NamespacedName,
+	day string,
+) ([]sample, error) {
+	var samples []sample
+	plans := svcsdk.NewGetUsagePlansPaginator(c.sdkapi, &svcsdk.GetUsagePlansInput{})
+	for plans.HasMorePages() {
+		page, err := plans.NextPage(ctx)
+		c.metrics.RecordAPICall("READ_MANY", "GetUsagePlans", err)
+		if err != nil {
+			return nil, err
+		}
+		for _, plan := range page.Items {
+			usage := svcsdk.NewGetUsagePaginator(c.sdkapi, &svcsdk.GetUsageInput{
+				UsagePlanId: plan.Id,
+				StartDate:   aws.String(day),
+				EndDate:     aws.String(day),
+			})
+			for usage.HasMorePages() {
+				out, err := usage.NextPage(ctx)
+				c.metrics.RecordAPICall("READ_MANY", "GetUsage", err)
+				if err != nil {
+					return nil, err
+				}
+				for id, days := range out.Items {
+					key, ok := ids[id]
+					if !ok || len(days) == 0 || len(days[len(days)-1]) == 0 {
+						continue
+					}
+					today := days[len(days)-1]
+					s := sample{
+						labels: prometheus.Labels{
+							"namespace":     key.Namespace,
+							"name":          key.Name,
+							"usage_plan_id": aws.ToString(plan.Id),
+						},
+						used: float64(today[0]),
+					}
+					if plan.Quota != nil && len(today) > 1 {
+						s.remaining = aws.Float64(float64(today[1]))
+					}
+					samples = append(samples, s)
+				}
+			}
+		}
+	}
+	return samples, nil
+}
@@ -0,0 +1,235 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package usage_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	ackcfg "github.com/aws-controllers-k8s/runtime/pkg/config"
+	ackmetrics "github.com/aws-controllers-k8s/runtime/pkg/metrics"
+	acktypes "github.com/aws-controllers-k8s/runtime/pkg/types"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/go-logr/logr"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/time/rate"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	svcapitypes "github.com/aws-controllers-k8s/apigateway-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/apigateway-controller/pkg/ratelimit"
+	svcresource "github.com/aws-controllers-k8s/apigateway-controller/pkg/resource"
+	"github.com/aws-controllers-k8s/apigateway-controller/pkg/usage"
+
+	_ "github.com/aws-controllers-k8s/apigateway-controller/pkg/resource/api_key"
+)
+
+// transport answers with the usage plans plan1, which has a quota, and plan2,
+// which has none, and with the usage of their keys, or with a throttling error
+// while throttle is set.
+type transport struct {
+	throttle bool
+	sent     []string
+}
+
+func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.sent = append(t.sent, req.Method+" "+req.URL.Path)
+	if t.throttle {
+		return &http.Response{
+			StatusCode: http.StatusTooManyRequests,
+			Header: http.Header{
+				"Content-Type":     []string{"application/json"},
+				"X-Amzn-Errortype": []string{ratelimit.ThrottlingErrorCode},
+			},
+			Body:    io.NopCloser(strings.NewReader(`{"message":"Too Many Requests"}`)),
+			Request: req,
+		}, nil
+	}
+	body := "{}"
+	switch req.URL.Path {
+	case "/usageplans":
+		body = `{"item": [{"id": "plan1", "quota": {"limit": 100, "period": "DAY"}}, {"id": "plan2"}]}`
+	case "/usageplans/plan1/usage":
+		body = `{"values": {"key1": [[5, 95]], "unmanaged": [[1, 99]]}}`
+	case "/usageplans/plan2/usage":
+		body = `{"values": {"key1": [[2, 0]], "key2": [[7, 0]], "key3": [[3, 0]]}}`
+	}
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       io.NopCloser(strings.NewReader(body)),
+		Request:    req,
+	}, nil
+}
+
+// apiKeys returns a client of the APIKeys objs.
+func apiKeys(t *testing.T, objs ...client.Object) client.Client {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	require.NoError(t, svcapitypes.AddToScheme(scheme))
+	return fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build()
+}
+
+// reporter returns a Reporter of the APIKeys of kc, and the factory of the
+// APIKey resource managers recording them, wrapped by the rate limits of
+// limiters.
+func reporter(
+	t *testing.T,
+	kc client.Reader,
+	reg *prometheus.Registry,
+	limiters *ratelimit.Limiters,
+) (*usage.Reporter, acktypes.AWSResourceManagerFactory) {
+	t.Helper()
+	r, err := usage.NewReporter(kc, time.Minute, logr.Discard(), reg)
+	require.NoError(t, err)
+	for _, f := range ratelimit.WrapManagerFactories(usage.WrapManagerFactories(svcresource.GetManagerFactories(), r), limiters) {
+		if f.ResourceDescriptor().GroupVersionKind().Kind == "APIKey" {
+			return r, f
+		}
+	}
+	require.Fail(t, "no APIKey resource manager factory")
+	return nil, nil
+}
+
+// read reads the APIKeys objs with a resource manager of an account in
+// us-west-2, whose client sends the requests with tr.
+func read(
+	t *testing.T,
+	f acktypes.AWSResourceManagerFactory,
+	account ackv1alpha1.AWSAccountID,
+	tr *transport,
+	objs ...*svcapitypes.APIKey,
+) {
+	t.Helper()
+	clientcfg := aws.Config{
+		Region:           "us-west-2",
+		Credentials:      credentials.NewStaticCredentialsProvider("AKID", "SECRET", ""),
+		HTTPClient:       &http.Client{Transport: tr},
+		RetryMaxAttempts: 1,
+	}
+	rm, err := f.ManagerFor(ackcfg.Config{}, clientcfg, logr.Discard(), ackmetrics.NewMetrics("apigateway"),
+		nil, account, "us-west-2", "")
+	require.NoError(t, err)
+	for _, ko := range objs {
+		_, _ = rm.ReadOne(context.Background(), f.ResourceDescriptor().ResourceFromRuntimeObject(ko))
+	}
+	tr.sent = nil
+}
+
+func apiKey(name, id string) *svcapitypes.APIKey {
+	return namespacedAPIKey("default", name, id)
+}
+
+func namespacedAPIKey(namespace, name, id string) *svcapitypes.APIKey {
+	return &svcapitypes.APIKey{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+		Status:     svcapitypes.APIKeyStatus{ID: aws.String(id)},
+	}
+}
+
+// gauges returns the values of the gauges of a metric by APIKey name and
+// usage plan.
+func gauges(t *testing.T, reg *prometheus.Registry, metric string) map[string]float64 {
+	t.Helper()
+	families, err := reg.Gather()
+	require.NoError(t, err)
+	values := map[string]float64{}
+	for _, f := range families {
+		if f.GetName() != metric {
+			continue
+		}
+		for _, m := range f.GetMetric() {
+			labels := map[string]string{}
+			for _, l := range m.GetLabel() {
+				labels[l.GetName()] = l.GetValue()
+			}
+			values[labels["namespace"]+"/"+labels["name"]+"/"+labels["usage_plan_id"]] = m.GetGauge().GetValue()
+		}
+	}
+	return values
+}
+
+func TestReport(t *testing.T) {
+	first, second := apiKey("first", "key1"), apiKey("second", "key2")
+	pending := apiKey("pending", "")
+	pending.Status.ID = nil
+	kc := apiKeys(t, first, second, pending, apiKey("unread", "key3"))
+	reg := prometheus.NewRegistry()
+	r, f := reporter(t, kc, reg, ratelimit.NewLimiters(ratelimit.Config{}))
+	tr := &transport{}
+	read(t, f, "111111111111", tr, first, second, pending)
+
+	// The keys not read by a resource manager yet are not reported.
+	require.NoError(t, r.Report(context.Background()))
+	assert.Equal(t, []string{
+		"GET /usageplans",
+		"GET /usageplans/plan1/usage",
+		"GET /usageplans/plan2/usage",
+	}, tr.sent)
+	assert.Equal(t, map[string]float64{
+		"default/first/plan1":  5,
+		"default/first/plan2":  2,
+		"default/second/plan2": 7,
+	}, gauges(t, reg, "apigateway_api_key_usage_requests"))
+	// Only the usage plans with a quota have a remaining quota.
+	assert.Equal(t, map[string]float64{
+		"default/first/plan1": 95,
+	}, gauges(t, reg, "apigateway_api_key_usage_remaining_quota"))
+
+	// The keys deleted since the last report are no longer reported.
+	require.NoError(t, kc.Delete(context.Background(), apiKey("second", "key2")))
+	require.NoError(t, r.Report(context.Background()))
+	assert.NotContains(t, gauges(t, reg, "apigateway_api_key_usage_requests"), "default/second/plan2")
+}
+
+func TestReport_Accounts(t *testing.T) {
+	first, third := apiKey("first", "key1"), namespacedAPIKey("tenant", "third", "key3")
+	kc := apiKeys(t, first, third)
+	reg := prometheus.NewRegistry()
+	limiters := ratelimit.NewLimiters(ratelimit.Config{ReadRate: 100, ReadBurst: 100})
+	r, f := reporter(t, kc, reg, limiters)
+	controllerTransport, tenantTransport := &transport{}, &transport{}
+	read(t, f, "111111111111", controllerTransport, first)
+	read(t, f, "222222222222", tenantTransport, third)
+
+	// The usage of each account is read with the client of its resource
+	// managers.
+	require.NoError(t, r.Report(context.Background()))
+	assert.NotEmpty(t, controllerTransport.sent)
+	assert.NotEmpty(t, tenantTransport.sent)
+	assert.Equal(t, map[string]float64{
+		"default/first/plan1": 5,
+		"default/first/plan2": 2,
+		"tenant/third/plan2":  3,
+	}, gauges(t, reg, "apigateway_api_key_usage_requests"))
+
+	// The usage of an account is read with the rate limits of the account,
+	// and its metrics are kept while it cannot be read.
+	tenantTransport.throttle = true
+	err := r.Report(context.Background())
+	assert.ErrorContains(t, err, "222222222222")
+	assert.Equal(t, rate.Limit(50), limiters.For("222222222222", "us-west-2").Limit("GetUsagePlans"))
+	assert.Equal(t, rate.Limit(100), limiters.For("111111111111", "us-west-2").Limit("GetUsagePlans"))
+	assert.Contains(t, gauges(t, reg, "apigateway_api_key_usage_requests"), "tenant/third/plan2")
+}
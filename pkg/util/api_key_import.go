@@ -0,0 +1,225 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package util
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/apigateway/types"
+
+	svcapitypes "github.com/aws-controllers-k8s/apigateway-controller/apis/v1alpha1"
+)
+
+// DefaultAPIKeyImportBatchSize is the number of rows imported by each
+// ImportApiKeys call when spec.batchSize of an APIKeyImport is not set.
+const DefaultAPIKeyImportBatchSize = 500
+
+const (
+	// APIKeyImportStatusImporting is the import status of an APIKeyImport
+	// while rows of its CSV file remain to be imported.
+	APIKeyImportStatusImporting = "IMPORTING"
+	// APIKeyImportStatusCompleted is the import status of an APIKeyImport
+	// once all the rows of its CSV file were imported.
+	APIKeyImportStatusCompleted = "COMPLETED"
+)
+
+// APIKeyImportCSV is the CSV file of an APIKeyImport.
+type APIKeyImportCSV struct {
+	header []string
+	rows   [][]string
+	// name is the index of the name column, or -1.
+	name int
+}
+
+// APIKeyImportBatch is a batch of consecutive rows of the CSV file of an
+// APIKeyImport, imported by a single ImportApiKeys call.
+type APIKeyImportBatch struct {
+	// First is the index of the first row of the batch, header excluded.
+	First int
+	// Names are the names of the API keys of the rows of the batch.
+	Names []string
+	// Total is the number of rows of the CSV file, header excluded.
+	Total int
+	// Body is the CSV file of the header and the rows of the batch.
+	Body []byte
+}
+
+// ParseAPIKeyImportCSV parses the CSV file of an APIKeyImport, whose first row
+// is the header naming the columns.
+func ParseAPIKeyImportCSV(data string) (*APIKeyImportCSV, error) {
+	records, err := csv.NewReader(strings.NewReader(data)).ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 {
+		return nil, errors.New("the CSV file has no header")
+	}
+	c := &APIKeyImportCSV{header: records[0], rows: records[1:], name: -1}
+	for i, column := range c.header {
+		if strings.EqualFold(strings.TrimSpace(column), "name") {
+			c.name = i
+		}
+	}
+	return c, nil
+}
+
+// Rows returns the number of rows of the CSV file, header excluded.
+func (c *APIKeyImportCSV) Rows() int {
+	return len(c.rows)
+}
+
+// NextBatch returns the batch of at most size rows following the first done
+// rows of the CSV file, or nil if no row follows them.
+func (c *APIKeyImportCSV) NextBatch(done, size int) (*APIKeyImportBatch, error) {
+	if done >= len(c.rows) {
+		return nil, nil
+	}
+	rows := c.rows[done:min(done+size, len(c.rows))]
+	batch := &APIKeyImportBatch{First: done, Total: len(c.rows)}
+	var body bytes.Buffer
+	w := csv.NewWriter(&body)
+	if err := w.Write(c.header); err != nil {
+		return nil, err
+	}
+	for _, row := range rows {
+		if err := w.Write(row); err != nil {
+			return nil, err
+		}
+		name := ""
+		if c.name >= 0 {
+			name = row[c.name]
+		}
+		batch.Names = append(batch.Names, name)
+	}
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return nil, err
+	}
+	batch.Body = body.Bytes()
+	return batch, nil
+}
+
+// NextAPIKeyImportBatch returns the batch of rows of the CSV file of an
+// APIKeyImport to import: the pending batch when one is recorded in its
+// status, and else the batch following the rows imported so far. It returns
+// nil if no row remains to be imported.
+func NextAPIKeyImportBatch(c *APIKeyImportCSV, ko *svcapitypes.APIKeyImport) (*APIKeyImportBatch, error) {
+	if pending := ko.Status.PendingBatch; pending != nil {
+		return c.NextBatch(int(aws.ToInt64(pending.First)), int(aws.ToInt64(pending.Size)))
+	}
+	return c.NextBatch(int(aws.ToInt64(ko.Status.ImportedRows)), APIKeyImportBatchSize(ko))
+}
+
+// SetAPIKeyImportPendingBatch records in the status of an APIKeyImport the
+// batch an ImportApiKeys call is about to import.
+func SetAPIKeyImportPendingBatch(ko *svcapitypes.APIKeyImport, batch *APIKeyImportBatch) {
+	ko.Status.PendingBatch = &svcapitypes.APIKeyImportPendingBatch{
+		First: aws.Int64(int64(batch.First)),
+		Size:  aws.Int64(int64(len(batch.Names))),
+	}
+}
+
+// APIKeyImportBatchSize returns the number of rows imported by each
+// ImportApiKeys call of an APIKeyImport.
+func APIKeyImportBatchSize(ko *svcapitypes.APIKeyImport) int {
+	if ko.Spec.BatchSize == nil || *ko.Spec.BatchSize < 1 {
+		return DefaultAPIKeyImportBatchSize
+	}
+	return int(*ko.Spec.BatchSize)
+}
+
+// RecordAPIKeyImportBatch records in the status of an APIKeyImport the import
+// of a batch as the API keys ids, and clears its pending batch. API Gateway
+// returns the identifiers in the order of the rows but leaves out the rows it
+// skipped with a warning, so when rows were skipped the identifiers are
+// matched to the rows by the names of their keys, which keyNames maps them to,
+// and the rows left over are recorded as failed. They are not when keyNames is
+// nil.
+func RecordAPIKeyImportBatch(
+	ko *svcapitypes.APIKeyImport,
+	batch *APIKeyImportBatch,
+	ids []string,
+	keyNames map[string]string,
+) {
+	if len(ids) < len(batch.Names) && keyNames != nil {
+		next := 0
+		for i, name := range batch.Names {
+			if next < len(ids) && keyNames[ids[next]] == name {
+				next++
+				continue
+			}
+			row := &svcapitypes.APIKeyImportRow{Row: aws.Int64(int64(batch.First + i + 1))}
+			if name != "" {
+				row.Name = aws.String(name)
+			}
+			ko.Status.FailedRows = append(ko.Status.FailedRows, row)
+		}
+	}
+	ko.Status.ImportedRows = aws.Int64(int64(batch.First + len(batch.Names)))
+	ko.Status.PendingBatch = nil
+	SetAPIKeyImportProgress(ko, batch.Total)
+}
+
+// PendingAPIKeyImportBatchIDs returns the identifiers of the API keys an
+// earlier ImportApiKeys call may have imported from the pending batch of an
+// APIKeyImport without its result being recorded, and the names of the keys
+// by identifier, or nil when none were imported. keys are the API keys created
+// since the APIKeyImport: those whose identifiers are not recorded yet are
+// taken for the batch in the order of their creation, at most one per row.
+func PendingAPIKeyImportBatchIDs(
+	ko *svcapitypes.APIKeyImport,
+	batch *APIKeyImportBatch,
+	keys []svcsdktypes.ApiKey,
+) ([]string, map[string]string) {
+	recorded := map[string]bool{}
+	for _, id := range ko.Status.IDs {
+		recorded[aws.ToString(id)] = true
+	}
+	var created []svcsdktypes.ApiKey
+	for _, key := range keys {
+		if !recorded[aws.ToString(key.Id)] {
+			created = append(created, key)
+		}
+	}
+	if len(created) == 0 {
+		return nil, nil
+	}
+	sort.SliceStable(created, func(i, j int) bool {
+		return aws.ToTime(created[i].CreatedDate).Before(aws.ToTime(created[j].CreatedDate))
+	})
+	var ids []string
+	keyNames := map[string]string{}
+	for _, key := range created[:min(len(created), len(batch.Names))] {
+		id := aws.ToString(key.Id)
+		ids = append(ids, id)
+		keyNames[id] = aws.ToString(key.Name)
+	}
+	return ids, keyNames
+}
+
+// SetAPIKeyImportProgress sets the number of rows of the CSV file of an
+// APIKeyImport and whether all of them were imported.
+func SetAPIKeyImportProgress(ko *svcapitypes.APIKeyImport, total int) {
+	ko.Status.TotalRows = aws.Int64(int64(total))
+	if aws.ToInt64(ko.Status.ImportedRows) < int64(total) {
+		ko.Status.ImportStatus = aws.String(APIKeyImportStatusImporting)
+	} else {
+		ko.Status.ImportStatus = aws.String(APIKeyImportStatusCompleted)
+	}
+}
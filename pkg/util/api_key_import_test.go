@@ -0,0 +1,132 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package util_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/apigateway/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	svcapitypes "github.com/aws-controllers-k8s/apigateway-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/apigateway-controller/pkg/util"
+)
+
+func TestAPIKeyImportBatches(t *testing.T) {
+	c, err := util.ParseAPIKeyImportCSV("Name,key,description,Enabled,usageplanIds\n" +
+		"a,key-a,\"first, key\",true,plan1\n" +
+		"b,key-b,,true,\n" +
+		"c,key-c,,false,\"plan1,plan2\"\n" +
+		"d,key-d,,true,\n")
+	require.NoError(t, err)
+	assert.Equal(t, 4, c.Rows())
+
+	ko := &svcapitypes.APIKeyImport{Spec: svcapitypes.APIKeyImportSpec{BatchSize: aws.Int64(2)}}
+	batch, err := c.NextBatch(int(aws.ToInt64(ko.Status.ImportedRows)), util.APIKeyImportBatchSize(ko))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a", "b"}, batch.Names)
+	assert.Equal(t, "Name,key,description,Enabled,usageplanIds\n"+
+		"a,key-a,\"first, key\",true,plan1\n"+
+		"b,key-b,,true,\n", string(batch.Body))
+
+	util.RecordAPIKeyImportBatch(ko, batch, []string{"id-a", "id-b"}, nil)
+	assert.Equal(t, util.APIKeyImportStatusImporting, *ko.Status.ImportStatus)
+	assert.Equal(t, int64(2), *ko.Status.ImportedRows)
+	assert.Equal(t, int64(4), *ko.Status.TotalRows)
+	assert.Empty(t, ko.Status.FailedRows)
+
+	batch, err = c.NextBatch(int(aws.ToInt64(ko.Status.ImportedRows)), util.APIKeyImportBatchSize(ko))
+	require.NoError(t, err)
+	assert.Equal(t, 2, batch.First)
+	assert.Equal(t, "Name,key,description,Enabled,usageplanIds\n"+
+		"c,key-c,,false,\"plan1,plan2\"\n"+
+		"d,key-d,,true,\n", string(batch.Body))
+
+	// The first row was skipped with a warning: the identifier is the one of
+	// the second row.
+	util.RecordAPIKeyImportBatch(ko, batch, []string{"id-d"}, map[string]string{"id-a": "a", "id-d": "d"})
+	assert.Equal(t, util.APIKeyImportStatusCompleted, *ko.Status.ImportStatus)
+	assert.Equal(t, int64(4), *ko.Status.ImportedRows)
+	assert.Equal(t, []*svcapitypes.APIKeyImportRow{{Row: aws.Int64(3), Name: aws.String("c")}}, ko.Status.FailedRows)
+
+	batch, err = c.NextBatch(int(aws.ToInt64(ko.Status.ImportedRows)), util.APIKeyImportBatchSize(ko))
+	require.NoError(t, err)
+	assert.Nil(t, batch)
+
+	// Rows appended to the file are imported too.
+	util.SetAPIKeyImportProgress(ko, 5)
+	assert.Equal(t, util.APIKeyImportStatusImporting, *ko.Status.ImportStatus)
+}
+
+func TestPendingAPIKeyImportBatch(t *testing.T) {
+	c, err := util.ParseAPIKeyImportCSV("name,key\na,key-a\nb,key-b\na,key-a2\n")
+	require.NoError(t, err)
+	ko := &svcapitypes.APIKeyImport{Spec: svcapitypes.APIKeyImportSpec{BatchSize: aws.Int64(2)}}
+	batch, err := util.NextAPIKeyImportBatch(c, ko)
+	require.NoError(t, err)
+	util.SetAPIKeyImportPendingBatch(ko, batch)
+	assert.Equal(t, &svcapitypes.APIKeyImportPendingBatch{First: aws.Int64(0), Size: aws.Int64(2)}, ko.Status.PendingBatch)
+
+	// The pending batch is reconciled even if the batch size changes.
+	ko.Spec.BatchSize = aws.Int64(3)
+	batch, err = util.NextAPIKeyImportBatch(c, ko)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a", "b"}, batch.Names)
+
+	created := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	key := func(id, name string, minutes int) svcsdktypes.ApiKey {
+		return svcsdktypes.ApiKey{
+			Id:          aws.String(id),
+			Name:        aws.String(name),
+			CreatedDate: aws.Time(created.Add(time.Duration(minutes) * time.Minute)),
+		}
+	}
+	ko.Status.IDs = aws.StringSlice([]string{"id-x"})
+
+	ids, keyNames := util.PendingAPIKeyImportBatchIDs(ko, batch, []svcsdktypes.ApiKey{key("id-x", "x", 0)})
+	assert.Nil(t, ids)
+	assert.Nil(t, keyNames)
+
+	// An earlier call imported the batch but skipped the row of a.
+	keys := []svcsdktypes.ApiKey{key("id-b", "b", 2), key("id-x", "x", 0)}
+	ids, keyNames = util.PendingAPIKeyImportBatchIDs(ko, batch, keys)
+	assert.Equal(t, []string{"id-b"}, ids)
+	util.RecordAPIKeyImportBatch(ko, batch, ids, keyNames)
+	assert.Equal(t, []*svcapitypes.APIKeyImportRow{{Row: aws.Int64(1), Name: aws.String("a")}}, ko.Status.FailedRows)
+	assert.Equal(t, int64(2), *ko.Status.ImportedRows)
+	assert.Nil(t, ko.Status.PendingBatch)
+
+	// Keys are taken in the order of their creation, at most one per row.
+	ko.Status.IDs = nil
+	keys = []svcsdktypes.ApiKey{key("id-c", "c", 3), key("id-b", "b", 2), key("id-a", "a", 1)}
+	ids, _ = util.PendingAPIKeyImportBatchIDs(ko, batch, keys)
+	assert.Equal(t, []string{"id-a", "id-b"}, ids)
+}
+
+func TestParseAPIKeyImportCSV(t *testing.T) {
+	_, err := util.ParseAPIKeyImportCSV("")
+	assert.Error(t, err)
+	_, err = util.ParseAPIKeyImportCSV("name,key\na,key-a,extra\n")
+	assert.Error(t, err)
+
+	c, err := util.ParseAPIKeyImportCSV("key\nkey-a\n")
+	require.NoError(t, err)
+	batch, err := c.NextBatch(0, util.DefaultAPIKeyImportBatchSize)
+	require.NoError(t, err)
+	assert.Equal(t, []string{""}, batch.Names)
+	assert.Equal(t, util.DefaultAPIKeyImportBatchSize, util.APIKeyImportBatchSize(&svcapitypes.APIKeyImport{}))
+}
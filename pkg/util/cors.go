@@ -0,0 +1,150 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package util
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+
+	svcapitypes "github.com/aws-controllers-k8s/apigateway-controller/apis/v1alpha1"
+)
+
+// Headers returned in responses to cross-origin requests.
+const (
+	CORSAllowOriginHeader      = "Access-Control-Allow-Origin"
+	CORSAllowMethodsHeader     = "Access-Control-Allow-Methods"
+	CORSAllowHeadersHeader     = "Access-Control-Allow-Headers"
+	CORSMaxAgeHeader           = "Access-Control-Max-Age"
+	CORSAllowCredentialsHeader = "Access-Control-Allow-Credentials"
+)
+
+var (
+	// CORSResponseHeaders are the headers that the CORS configuration of a
+	// Resource adds to the responses of its methods other than OPTIONS.
+	CORSResponseHeaders = []string{CORSAllowOriginHeader, CORSAllowCredentialsHeader}
+	// defaultCORSAllowHeaders are the request headers allowed when the CORS
+	// configuration does not list any, the same as the API Gateway console.
+	defaultCORSAllowHeaders = []string{"Content-Type", "X-Amz-Date", "Authorization", "X-Api-Key", "X-Amz-Security-Token"}
+	// anyMethodHTTPMethods are the methods handled by an ANY method.
+	anyMethodHTTPMethods = []string{"DELETE", "GET", "HEAD", "OPTIONS", "PATCH", "POST", "PUT"}
+)
+
+// ResponseHeaderParameter returns the name of the method response parameter
+// that declares a response header.
+func ResponseHeaderParameter(header string) string {
+	return "method.response.header." + header
+}
+
+// CORSPreflight describes the 200 response of the OPTIONS method that answers
+// CORS preflight requests with a MOCK integration.
+type CORSPreflight struct {
+	// MethodResponseParameters declares the CORS headers of the method
+	// response.
+	MethodResponseParameters map[string]bool
+	// IntegrationResponseParameters maps the CORS headers of the method
+	// response to static values.
+	IntegrationResponseParameters map[string]string
+	// IntegrationResponseTemplates sets the Allow-Origin header to the Origin
+	// of the request when it is one of several allowed origins.
+	IntegrationResponseTemplates map[string]string
+}
+
+// NewCORSPreflight returns the preflight response for a CORS configuration of
+// a resource whose methods are httpMethods.
+func NewCORSPreflight(cors *svcapitypes.CORSConfiguration, httpMethods []string) *CORSPreflight {
+	allowMethods := aws.ToStringSlice(cors.AllowMethods)
+	if len(allowMethods) == 0 {
+		allowMethods = corsMethods(httpMethods)
+	}
+	allowHeaders := aws.ToStringSlice(cors.AllowHeaders)
+	if len(allowHeaders) == 0 {
+		allowHeaders = defaultCORSAllowHeaders
+	}
+	values := map[string]string{
+		CORSAllowMethodsHeader: strings.Join(allowMethods, ","),
+		CORSAllowHeadersHeader: strings.Join(allowHeaders, ","),
+	}
+	if cors.MaxAge != nil {
+		values[CORSMaxAgeHeader] = strconv.FormatInt(*cors.MaxAge, 10)
+	}
+	if aws.ToBool(cors.AllowCredentials) {
+		values[CORSAllowCredentialsHeader] = "true"
+	}
+
+	preflight := &CORSPreflight{
+		MethodResponseParameters: map[string]bool{
+			ResponseHeaderParameter(CORSAllowOriginHeader): false,
+		},
+		IntegrationResponseParameters: map[string]string{},
+	}
+	for header, value := range values {
+		preflight.MethodResponseParameters[ResponseHeaderParameter(header)] = false
+		preflight.IntegrationResponseParameters[ResponseHeaderParameter(header)] = staticValue(value)
+	}
+	if origin, ok := CORSAllowOrigin(cors); ok {
+		preflight.IntegrationResponseParameters[ResponseHeaderParameter(CORSAllowOriginHeader)] = origin
+	} else {
+		preflight.IntegrationResponseTemplates = map[string]string{
+			"application/json": allowOriginTemplate(aws.ToStringSlice(cors.AllowOrigins)),
+		}
+	}
+	return preflight
+}
+
+// CORSAllowOrigin returns the static value that the integration responses of
+// a resource map to the Allow-Origin header, and false if the CORS
+// configuration allows more than one origin.
+func CORSAllowOrigin(cors *svcapitypes.CORSConfiguration) (string, bool) {
+	if len(cors.AllowOrigins) != 1 {
+		return "", false
+	}
+	return staticValue(aws.ToString(cors.AllowOrigins[0])), true
+}
+
+// corsMethods returns the methods allowed by default for a resource whose
+// methods are httpMethods.
+func corsMethods(httpMethods []string) []string {
+	if slices.Contains(httpMethods, "ANY") {
+		return anyMethodHTTPMethods
+	}
+	methods := append([]string{"OPTIONS"}, httpMethods...)
+	slices.Sort(methods)
+	return slices.Compact(methods)
+}
+
+// allowOriginTemplate returns a mapping template that sets the Allow-Origin
+// header to the Origin of the request if it is one of origins.
+func allowOriginTemplate(origins []string) string {
+	conditions := make([]string, 0, len(origins))
+	for _, origin := range origins {
+		conditions = append(conditions, fmt.Sprintf("$origin == %q", origin))
+	}
+	return strings.Join([]string{
+		`#set($origin = $input.params().header.get("Origin"))`,
+		`#if("$!origin" == "")#set($origin = $input.params().header.get("origin"))#end`,
+		fmt.Sprintf("#if(%s)", strings.Join(conditions, " || ")),
+		`#set($context.responseOverride.header.Access-Control-Allow-Origin = $origin)`,
+		`#end`,
+		`{}`,
+	}, "\n")
+}
+
+// staticValue returns the mapping expression of a static header value.
+func staticValue(value string) string {
+	return "'" + value + "'"
+}
@@ -0,0 +1,66 @@
+package util_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/stretchr/testify/assert"
+
+	svcapitypes "github.com/aws-controllers-k8s/apigateway-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/apigateway-controller/pkg/util"
+)
+
+func TestNewCORSPreflightSingleOrigin(t *testing.T) {
+	cors := &svcapitypes.CORSConfiguration{
+		AllowOrigins:     aws.StringSlice([]string{"https://example.com"}),
+		MaxAge:           aws.Int64(600),
+		AllowCredentials: aws.Bool(true),
+	}
+
+	preflight := util.NewCORSPreflight(cors, []string{"POST", "GET"})
+
+	assert.Equal(t, map[string]bool{
+		"method.response.header.Access-Control-Allow-Origin":      false,
+		"method.response.header.Access-Control-Allow-Methods":     false,
+		"method.response.header.Access-Control-Allow-Headers":     false,
+		"method.response.header.Access-Control-Max-Age":           false,
+		"method.response.header.Access-Control-Allow-Credentials": false,
+	}, preflight.MethodResponseParameters)
+	assert.Equal(t, map[string]string{
+		"method.response.header.Access-Control-Allow-Origin":      "'https://example.com'",
+		"method.response.header.Access-Control-Allow-Methods":     "'GET,OPTIONS,POST'",
+		"method.response.header.Access-Control-Allow-Headers":     "'Content-Type,X-Amz-Date,Authorization,X-Api-Key,X-Amz-Security-Token'",
+		"method.response.header.Access-Control-Max-Age":           "'600'",
+		"method.response.header.Access-Control-Allow-Credentials": "'true'",
+	}, preflight.IntegrationResponseParameters)
+	assert.Nil(t, preflight.IntegrationResponseTemplates)
+}
+
+func TestNewCORSPreflightMultipleOrigins(t *testing.T) {
+	cors := &svcapitypes.CORSConfiguration{
+		AllowOrigins: aws.StringSlice([]string{"https://a.example.com", "https://b.example.com"}),
+		AllowMethods: aws.StringSlice([]string{"GET"}),
+		AllowHeaders: aws.StringSlice([]string{"Content-Type"}),
+	}
+
+	preflight := util.NewCORSPreflight(cors, []string{"ANY"})
+
+	assert.Contains(t, preflight.MethodResponseParameters, "method.response.header.Access-Control-Allow-Origin")
+	assert.NotContains(t, preflight.IntegrationResponseParameters, "method.response.header.Access-Control-Allow-Origin")
+	assert.Equal(t, "'GET'", preflight.IntegrationResponseParameters["method.response.header.Access-Control-Allow-Methods"])
+	assert.Equal(t, "'Content-Type'", preflight.IntegrationResponseParameters["method.response.header.Access-Control-Allow-Headers"])
+	assert.Contains(t, preflight.IntegrationResponseTemplates["application/json"],
+		`#if($origin == "https://a.example.com" || $origin == "https://b.example.com")`)
+
+	_, ok := util.CORSAllowOrigin(cors)
+	assert.False(t, ok)
+}
+
+func TestNewCORSPreflightAnyMethod(t *testing.T) {
+	cors := &svcapitypes.CORSConfiguration{AllowOrigins: aws.StringSlice([]string{"*"})}
+
+	preflight := util.NewCORSPreflight(cors, []string{"ANY"})
+
+	assert.Equal(t, "'DELETE,GET,HEAD,OPTIONS,PATCH,POST,PUT'", preflight.IntegrationResponseParameters["method.response.header.Access-Control-Allow-Methods"])
+	assert.Equal(t, "'*'", preflight.IntegrationResponseParameters["method.response.header.Access-Control-Allow-Origin"])
+}
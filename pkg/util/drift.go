@@ -0,0 +1,182 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package util
+
+import (
+	"path"
+	"reflect"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// AnnotationIgnoreDrift is the annotation listing, separated by commas or new
+// lines, the spec paths whose differences with the AWS resource are ignored,
+// e.g. "spec.policy, spec.variables.release-*".
+//
+// Path elements are spec field names, in their Go or JSON form. A map field
+// is followed by a key pattern, matched with path.Match, which ends the path
+// and may contain dots, e.g. "spec.requestParameters.method.request.header.*".
+const AnnotationIgnoreDrift = "apigateway.services.k8s.aws/ignore-drift"
+
+// IgnoredPaths returns the spec paths listed in the ignore-drift annotation of
+// a resource.
+func IgnoredPaths(obj metav1.Object) []string {
+	value, ok := obj.GetAnnotations()[AnnotationIgnoreDrift]
+	if !ok {
+		return nil
+	}
+	var paths []string
+	for _, p := range strings.FieldsFunc(value, func(r rune) bool { return r == ',' || r == '\n' }) {
+		if p = strings.TrimSpace(p); p != "" {
+			paths = append(paths, p)
+		}
+	}
+	return paths
+}
+
+// IgnoreDrift sets, in the spec of latest, copies of the values that desired
+// has at the paths listed in the ignore-drift annotation of desired, so that
+// comparing them finds no difference and no update is sent for those paths.
+// desired is left as is, since it is the spec written by the user and is
+// patched back onto the custom resource. desired and latest are pointers to
+// custom resources with a Spec field.
+func IgnoreDrift(desired, latest metav1.Object) {
+	for _, p := range IgnoredPaths(desired) {
+		parts := strings.Split(p, ".")
+		if len(parts) < 2 || !strings.EqualFold(parts[0], "spec") {
+			continue
+		}
+		src := reflect.ValueOf(desired).Elem().FieldByName("Spec")
+		dst := reflect.ValueOf(latest).Elem().FieldByName("Spec")
+		if !src.IsValid() || !dst.IsValid() {
+			continue
+		}
+		ignorePath(dst, src, parts[1:])
+	}
+}
+
+// ignorePath sets dst to a copy of src at the path made of parts.
+func ignorePath(dst, src reflect.Value, parts []string) {
+	if len(parts) == 0 {
+		dst.Set(copyValue(src))
+		return
+	}
+	switch dst.Kind() {
+	case reflect.Ptr:
+		if dst.IsNil() && src.IsNil() {
+			return
+		}
+		if src.IsNil() {
+			// The path is unset in desired: unset it in a copy of latest,
+			// which is dropped if nothing else is set.
+			elem := reflect.New(dst.Type().Elem())
+			elem.Elem().Set(dst.Elem())
+			ignorePath(elem.Elem(), reflect.New(src.Type().Elem()).Elem(), parts)
+			if elem.Elem().IsZero() {
+				elem = reflect.Zero(dst.Type())
+			}
+			dst.Set(elem)
+			return
+		}
+		if dst.IsNil() {
+			dst.Set(reflect.New(dst.Type().Elem()))
+		}
+		ignorePath(dst.Elem(), src.Elem(), parts)
+	case reflect.Struct:
+		i, ok := fieldIndex(dst.Type(), parts[0])
+		if !ok {
+			return
+		}
+		ignorePath(dst.Field(i), src.Field(i), parts[1:])
+	case reflect.Map:
+		if dst.Type().Key().Kind() != reflect.String {
+			return
+		}
+		pattern := strings.Join(parts, ".")
+		matches := func(k reflect.Value) bool {
+			matched, err := path.Match(pattern, k.String())
+			return err == nil && matched
+		}
+		m := reflect.MakeMap(dst.Type())
+		for _, k := range dst.MapKeys() {
+			if !matches(k) {
+				m.SetMapIndex(k, dst.MapIndex(k))
+			}
+		}
+		for _, k := range src.MapKeys() {
+			if matches(k) {
+				m.SetMapIndex(k, copyValue(src.MapIndex(k)))
+			}
+		}
+		if src.IsNil() && m.Len() == 0 {
+			m = reflect.Zero(dst.Type())
+		}
+		dst.Set(m)
+	}
+}
+
+// copyValue returns a deep copy of v.
+func copyValue(v reflect.Value) reflect.Value {
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() {
+			return v
+		}
+		c := reflect.New(v.Type().Elem())
+		c.Elem().Set(copyValue(v.Elem()))
+		return c
+	case reflect.Slice:
+		if v.IsNil() {
+			return v
+		}
+		c := reflect.MakeSlice(v.Type(), v.Len(), v.Len())
+		for i := 0; i < v.Len(); i++ {
+			c.Index(i).Set(copyValue(v.Index(i)))
+		}
+		return c
+	case reflect.Map:
+		if v.IsNil() {
+			return v
+		}
+		c := reflect.MakeMapWithSize(v.Type(), v.Len())
+		for _, k := range v.MapKeys() {
+			c.SetMapIndex(k, copyValue(v.MapIndex(k)))
+		}
+		return c
+	case reflect.Struct:
+		c := reflect.New(v.Type()).Elem()
+		c.Set(v)
+		for i := 0; i < v.NumField(); i++ {
+			if v.Type().Field(i).IsExported() {
+				c.Field(i).Set(copyValue(v.Field(i)))
+			}
+		}
+		return c
+	}
+	return v
+}
+
+// fieldIndex returns the index of the field of t named name, in its Go or
+// JSON form, ignoring case.
+func fieldIndex(t reflect.Type, name string) (int, bool) {
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		jsonName, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+		if strings.EqualFold(f.Name, name) || strings.EqualFold(jsonName, name) {
+			return i, true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,92 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package util_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	svcapitypes "github.com/aws-controllers-k8s/apigateway-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/apigateway-controller/pkg/util"
+)
+
+func TestIgnoredPaths(t *testing.T) {
+	obj := &metav1.ObjectMeta{Annotations: map[string]string{
+		util.AnnotationIgnoreDrift: "spec.policy, Spec.Variables.release-*\n\nspec.description",
+	}}
+	assert.Equal(t, []string{"spec.policy", "Spec.Variables.release-*", "spec.description"}, util.IgnoredPaths(obj))
+	assert.Nil(t, util.IgnoredPaths(&metav1.ObjectMeta{}))
+}
+
+func TestIgnoreDrift(t *testing.T) {
+	desired := &svcapitypes.Stage{
+		ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{
+			util.AnnotationIgnoreDrift: "spec.variables.release-*, spec.canarySettings.percentTraffic, spec.unknown, status.id",
+		}},
+		Spec: svcapitypes.StageSpec{
+			Description: aws.String("desired"),
+			Variables: map[string]*string{
+				"env":           aws.String("prod"),
+				"release-stale": aws.String("1"),
+			},
+		},
+	}
+	latestVariables := map[string]*string{
+		"env":         aws.String("dev"),
+		"release-tag": aws.String("v2"),
+	}
+	latest := &svcapitypes.Stage{
+		Spec: svcapitypes.StageSpec{
+			Description:    aws.String("latest"),
+			Variables:      latestVariables,
+			CanarySettings: &svcapitypes.CanarySettings{PercentTraffic: aws.Float64(10)},
+		},
+	}
+	original := desired.DeepCopy()
+
+	util.IgnoreDrift(desired, latest)
+
+	assert.Equal(t, original, desired)
+	assert.Equal(t, "latest", *latest.Spec.Description)
+	assert.Equal(t, map[string]*string{
+		"env":           aws.String("dev"),
+		"release-stale": aws.String("1"),
+	}, latest.Spec.Variables)
+	assert.Nil(t, latest.Spec.CanarySettings)
+	assert.Len(t, latestVariables, 2)
+	assert.Equal(t, "v2", *latestVariables["release-tag"])
+
+	*latest.Spec.Variables["release-stale"] = "2"
+	assert.Equal(t, "1", *desired.Spec.Variables["release-stale"])
+}
+
+func TestIgnoreDrift_WholeField(t *testing.T) {
+	desired := &svcapitypes.RestAPI{
+		ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{util.AnnotationIgnoreDrift: "Spec.Policy"}},
+		Spec:       svcapitypes.RestAPISpec{Policy: aws.String(`{"Version": "2012-10-17"}`)},
+	}
+	latest := &svcapitypes.RestAPI{Spec: svcapitypes.RestAPISpec{Policy: aws.String("{}")}}
+	util.IgnoreDrift(desired, latest)
+	assert.Equal(t, `{"Version": "2012-10-17"}`, *latest.Spec.Policy)
+	assert.Equal(t, `{"Version": "2012-10-17"}`, *desired.Spec.Policy)
+	assert.NotSame(t, desired.Spec.Policy, latest.Spec.Policy)
+
+	desired.Annotations = nil
+	latest.Spec.Policy = aws.String("{}")
+	util.IgnoreDrift(desired, latest)
+	assert.Equal(t, "{}", *latest.Spec.Policy)
+}
@@ -0,0 +1,15 @@
+package util_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/aws-controllers-k8s/apigateway-controller/pkg/util"
+)
+
+func TestJSONEqual(t *testing.T) {
+	assert.True(t, util.JSONEqual(`{"a": 1, "b": [true]}`, `{"b":[true],"a":1}`))
+	assert.False(t, util.JSONEqual(`{"a": 1}`, `{"a": "1"}`))
+	assert.False(t, util.JSONEqual(`{"a": 1}`, `not json`))
+}
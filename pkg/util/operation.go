@@ -0,0 +1,50 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package util
+
+import (
+	"reflect"
+	"strings"
+)
+
+// readOperationPrefixes are the prefixes of the API Gateway operations that
+// do not modify anything.
+var readOperationPrefixes = []string{"Get", "TestInvoke"}
+
+// IsReadOperation returns whether the API Gateway operation does not modify
+// anything. The operations that do are withheld in dry-run and observe-only
+// modes, serialized per RestAPI, invalidate the cached resource trees and are
+// rate limited apart from the reads.
+func IsReadOperation(operation string) bool {
+	for _, prefix := range readOperationPrefixes {
+		if strings.HasPrefix(operation, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// RestAPIID returns the RestApiId field of the input of an API Gateway call,
+// or "" if the call does not target a RestAPI.
+func RestAPIID(input interface{}) string {
+	v := reflect.Indirect(reflect.ValueOf(input))
+	if v.Kind() != reflect.Struct {
+		return ""
+	}
+	f := v.FieldByName("RestApiId")
+	if !f.IsValid() || f.Kind() != reflect.Pointer || f.IsNil() || f.Elem().Kind() != reflect.String {
+		return ""
+	}
+	return f.Elem().String()
+}
@@ -0,0 +1,40 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package util_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/aws-controllers-k8s/apigateway-controller/pkg/util"
+)
+
+func TestIsReadOperation(t *testing.T) {
+	assert.True(t, util.IsReadOperation("GetRestApi"))
+	assert.True(t, util.IsReadOperation("TestInvokeMethod"))
+	assert.False(t, util.IsReadOperation("PutMethod"))
+	assert.False(t, util.IsReadOperation("ImportApiKeys"))
+}
+
+func TestRestAPIID(t *testing.T) {
+	type input struct {
+		RestApiId *string
+	}
+	assert.Equal(t, "api1", util.RestAPIID(&input{RestApiId: aws.String("api1")}))
+	assert.Equal(t, "", util.RestAPIID(&input{}))
+	assert.Equal(t, "", util.RestAPIID(&struct{ Name *string }{Name: aws.String("api1")}))
+	assert.Equal(t, "", util.RestAPIID(nil))
+}
@@ -0,0 +1,259 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package util
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/apigateway/types"
+
+	svcapitypes "github.com/aws-controllers-k8s/apigateway-controller/apis/v1alpha1"
+)
+
+// policyListKeys are the statement elements whose value is a string or a list
+// of strings.
+var policyListKeys = []string{"Action", "NotAction", "Resource", "NotResource"}
+
+// PolicyEqual returns true if a and b are the same IAM policy document of the
+// REST API restAPIID, regardless of formatting, escaping, statement and value
+// order, single values written as strings or lists, and of execute-api ARNs
+// written in full or as "execute-api:/..." for the REST API itself.
+func PolicyEqual(a, b string, restAPIID string) bool {
+	av, err := normalizePolicy(a, restAPIID)
+	if err != nil {
+		return false
+	}
+	bv, err := normalizePolicy(b, restAPIID)
+	if err != nil {
+		return false
+	}
+	return reflect.DeepEqual(av, bv)
+}
+
+// normalizePolicy parses an IAM policy document, as written or as returned,
+// escaped, by API Gateway, into its canonical form.
+func normalizePolicy(policy string, restAPIID string) (interface{}, error) {
+	var doc interface{}
+	if err := json.Unmarshal([]byte(policy), &doc); err != nil {
+		// API Gateway returns the policy with its quotes escaped.
+		var unescaped string
+		if err := json.Unmarshal([]byte(`"`+policy+`"`), &unescaped); err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal([]byte(unescaped), &doc); err != nil {
+			return nil, err
+		}
+	}
+	m, ok := doc.(map[string]interface{})
+	if !ok {
+		return doc, nil
+	}
+	var expanded *regexp.Regexp
+	if restAPIID != "" {
+		expanded = regexp.MustCompile(`^arn:[^:]+:execute-api:[^:]*:[^:]*:` + regexp.QuoteMeta(restAPIID) + `/`)
+	}
+	statements := toList(m["Statement"])
+	for i, s := range statements {
+		statements[i] = normalizeStatement(s, expanded)
+	}
+	sortValues(statements)
+	if statements != nil {
+		m["Statement"] = statements
+	}
+	return m, nil
+}
+
+// normalizeStatement returns the canonical form of a policy statement. The
+// execute-api ARNs of its resources matching expanded are written as
+// "execute-api:/...".
+func normalizeStatement(statement interface{}, expanded *regexp.Regexp) interface{} {
+	s, ok := statement.(map[string]interface{})
+	if !ok {
+		return statement
+	}
+	for _, key := range policyListKeys {
+		v, ok := s[key]
+		if !ok {
+			continue
+		}
+		values := toList(v)
+		if expanded != nil && strings.HasSuffix(key, "Resource") {
+			for i, r := range values {
+				if r, ok := r.(string); ok {
+					values[i] = expanded.ReplaceAllString(r, "execute-api:/")
+				}
+			}
+		}
+		sortValues(values)
+		s[key] = values
+	}
+	for _, key := range []string{"Principal", "NotPrincipal"} {
+		if principals, ok := s[key].(map[string]interface{}); ok {
+			for k, v := range principals {
+				values := toList(v)
+				sortValues(values)
+				principals[k] = values
+			}
+		}
+	}
+	if conditions, ok := s["Condition"].(map[string]interface{}); ok {
+		for _, c := range conditions {
+			if c, ok := c.(map[string]interface{}); ok {
+				for k, v := range c {
+					values := toList(v)
+					sortValues(values)
+					c[k] = values
+				}
+			}
+		}
+	}
+	return s
+}
+
+// toList returns v as a list, wrapping single values.
+func toList(v interface{}) []interface{} {
+	switch t := v.(type) {
+	case nil:
+		return nil
+	case []interface{}:
+		return t
+	default:
+		return []interface{}{t}
+	}
+}
+
+// sortValues sorts values by their JSON encoding.
+func sortValues(values []interface{}) {
+	sort.SliceStable(values, func(i, j int) bool {
+		// Values decoded from JSON always encode.
+		a, _ := json.Marshal(values[i])
+		b, _ := json.Marshal(values[j])
+		return string(a) < string(b)
+	})
+}
+
+// policyDocument is an IAM policy document rendered from a policy spec.
+type policyDocument struct {
+	Version   string            `json:"Version"`
+	Statement []policyStatement `json:"Statement"`
+}
+
+type policyStatement struct {
+	Effect    string                         `json:"Effect"`
+	Principal interface{}                    `json:"Principal"`
+	Action    string                         `json:"Action"`
+	Resource  []string                       `json:"Resource"`
+	Condition map[string]map[string][]string `json:"Condition,omitempty"`
+}
+
+// RenderRestAPIPolicy renders the policy spec of a REST API with the endpoint
+// configuration endpoint into an IAM policy document.
+func RenderRestAPIPolicy(
+	spec *svcapitypes.RestAPIPolicySpec,
+	endpoint *svcapitypes.EndpointConfiguration,
+) (string, error) {
+	sourceIPKey := "aws:SourceIp"
+	var vpcEndpointIDs []string
+	if endpoint != nil {
+		for _, t := range endpoint.Types {
+			if aws.ToString(t) == string(svcsdktypes.EndpointTypePrivate) {
+				sourceIPKey = "aws:VpcSourceIp"
+			}
+		}
+		if aws.ToBool(spec.AllowEndpointConfigurationVPCEndpoints) {
+			vpcEndpointIDs = aws.ToStringSlice(endpoint.VPCEndpointIDs)
+		}
+	}
+	if aws.ToBool(spec.AllowEndpointConfigurationVPCEndpoints) && len(vpcEndpointIDs) == 0 {
+		return "", errors.New("policySpec.allowEndpointConfigurationVPCEndpoints is set but " +
+			"spec.endpointConfiguration has no VPC endpoint")
+	}
+	vpcEndpointIDs = append(vpcEndpointIDs, aws.ToStringSlice(spec.SourceVPCEndpointIDs)...)
+
+	all := []string{"execute-api:/*"}
+	doc := policyDocument{
+		Version: "2012-10-17",
+		Statement: []policyStatement{{
+			Effect:    "Allow",
+			Principal: policyPrincipal(spec.Principals),
+			Action:    "execute-api:Invoke",
+			Resource:  all,
+		}},
+	}
+	if len(vpcEndpointIDs) > 0 {
+		doc.Statement = append(doc.Statement, policyStatement{
+			Effect:    "Deny",
+			Principal: "*",
+			Action:    "execute-api:Invoke",
+			Resource:  all,
+			Condition: map[string]map[string][]string{
+				"StringNotEquals": {"aws:SourceVpce": vpcEndpointIDs},
+			},
+		})
+	}
+	if len(spec.SourceIPs) > 0 {
+		doc.Statement = append(doc.Statement, policyStatement{
+			Effect:    "Deny",
+			Principal: "*",
+			Action:    "execute-api:Invoke",
+			Resource:  all,
+			Condition: map[string]map[string][]string{
+				"NotIpAddress": {sourceIPKey: aws.ToStringSlice(spec.SourceIPs)},
+			},
+		})
+	}
+	for _, deny := range spec.Denies {
+		if deny == nil {
+			continue
+		}
+		statement := policyStatement{
+			Effect:    "Deny",
+			Principal: policyPrincipal(deny.Principals),
+			Action:    "execute-api:Invoke",
+			Resource:  all,
+		}
+		if len(deny.Resources) > 0 {
+			statement.Resource = nil
+			for _, r := range deny.Resources {
+				statement.Resource = append(statement.Resource, "execute-api:/"+strings.TrimPrefix(aws.ToString(r), "/"))
+			}
+		}
+		if len(deny.SourceIPs) > 0 {
+			statement.Condition = map[string]map[string][]string{
+				"IpAddress": {sourceIPKey: aws.ToStringSlice(deny.SourceIPs)},
+			}
+		}
+		doc.Statement = append(doc.Statement, statement)
+	}
+	b, err := json.Marshal(doc)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// policyPrincipal returns the Principal element of a statement applying to
+// principals, or to any principal when there are none.
+func policyPrincipal(principals []*string) interface{} {
+	if len(principals) == 0 {
+		return "*"
+	}
+	return map[string][]string{"AWS": aws.ToStringSlice(principals)}
+}
@@ -0,0 +1,112 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package util_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	svcapitypes "github.com/aws-controllers-k8s/apigateway-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/apigateway-controller/pkg/util"
+)
+
+const desiredPolicy = `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": "*",
+      "Action": "execute-api:Invoke",
+      "Resource": "execute-api:/*"
+    },
+    {
+      "Effect": "Deny",
+      "Principal": {"AWS": ["arn:aws:iam::123456789012:root", "arn:aws:iam::210987654321:root"]},
+      "Action": ["execute-api:Invoke"],
+      "Resource": ["execute-api:/prod/GET/*", "execute-api:/prod/POST/*"],
+      "Condition": {"NotIpAddress": {"aws:SourceIp": "192.0.2.0/24"}}
+    }
+  ]
+}`
+
+// returnedPolicy is desiredPolicy as returned by GetRestApi.
+const returnedPolicy = `{\"Version\":\"2012-10-17\",\"Statement\":[` +
+	`{\"Effect\":\"Deny\",\"Principal\":{\"AWS\":[\"arn:aws:iam::210987654321:root\",\"arn:aws:iam::123456789012:root\"]},` +
+	`\"Action\":\"execute-api:Invoke\",` +
+	`\"Resource\":[\"arn:aws:execute-api:us-west-2:123456789012:abc123\/prod\/POST\/*\",\"arn:aws:execute-api:us-west-2:123456789012:abc123\/prod\/GET\/*\"],` +
+	`\"Condition\":{\"NotIpAddress\":{\"aws:SourceIp\":[\"192.0.2.0\/24\"]}}},` +
+	`{\"Effect\":\"Allow\",\"Principal\":\"*\",\"Action\":\"execute-api:Invoke\",` +
+	`\"Resource\":\"arn:aws:execute-api:us-west-2:123456789012:abc123\/*\"}]}`
+
+func TestPolicyEqual(t *testing.T) {
+	assert.True(t, util.PolicyEqual(desiredPolicy, returnedPolicy, "abc123"))
+	assert.True(t, util.PolicyEqual(returnedPolicy, returnedPolicy, ""))
+
+	// The ARNs of another REST API are not the REST API's own.
+	assert.False(t, util.PolicyEqual(desiredPolicy, returnedPolicy, "other"))
+	assert.False(t, util.PolicyEqual(desiredPolicy, `{"Version": "2012-10-17", "Statement": []}`, "abc123"))
+	assert.False(t, util.PolicyEqual(desiredPolicy, "not a policy", "abc123"))
+}
+
+func TestRenderRestAPIPolicy(t *testing.T) {
+	spec := &svcapitypes.RestAPIPolicySpec{
+		SourceVPCEndpointIDs:                   aws.StringSlice([]string{"vpce-2"}),
+		AllowEndpointConfigurationVPCEndpoints: aws.Bool(true),
+		SourceIPs:                              aws.StringSlice([]string{"10.0.0.0/16"}),
+		Denies: []*svcapitypes.RestAPIPolicyDeny{{
+			Resources:  aws.StringSlice([]string{"/prod/DELETE/*"}),
+			Principals: aws.StringSlice([]string{"123456789012"}),
+		}},
+	}
+	endpoint := &svcapitypes.EndpointConfiguration{
+		Types:          aws.StringSlice([]string{"PRIVATE"}),
+		VPCEndpointIDs: aws.StringSlice([]string{"vpce-1"}),
+	}
+
+	policy, err := util.RenderRestAPIPolicy(spec, endpoint)
+	require.NoError(t, err)
+	assert.True(t, util.PolicyEqual(`{
+  "Version": "2012-10-17",
+  "Statement": [
+    {"Effect": "Allow", "Principal": "*", "Action": "execute-api:Invoke", "Resource": "execute-api:/*"},
+    {
+      "Effect": "Deny", "Principal": "*", "Action": "execute-api:Invoke", "Resource": "execute-api:/*",
+      "Condition": {"StringNotEquals": {"aws:SourceVpce": ["vpce-2", "vpce-1"]}}
+    },
+    {
+      "Effect": "Deny", "Principal": "*", "Action": "execute-api:Invoke", "Resource": "execute-api:/*",
+      "Condition": {"NotIpAddress": {"aws:VpcSourceIp": "10.0.0.0/16"}}
+    },
+    {
+      "Effect": "Deny", "Principal": {"AWS": "123456789012"}, "Action": "execute-api:Invoke",
+      "Resource": "execute-api:/prod/DELETE/*"
+    }
+  ]
+}`, policy, ""), policy)
+
+	// Without VPC endpoints in the endpoint configuration.
+	_, err = util.RenderRestAPIPolicy(spec, &svcapitypes.EndpointConfiguration{})
+	assert.Error(t, err)
+
+	policy, err = util.RenderRestAPIPolicy(&svcapitypes.RestAPIPolicySpec{
+		Principals: aws.StringSlice([]string{"arn:aws:iam::123456789012:role/caller"}),
+		SourceIPs:  aws.StringSlice([]string{"192.0.2.0/24"}),
+	}, nil)
+	require.NoError(t, err)
+	assert.Contains(t, policy, `"aws:SourceIp"`)
+	assert.Contains(t, policy, `{"AWS":["arn:aws:iam::123456789012:role/caller"]}`)
+}
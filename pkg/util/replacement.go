@@ -0,0 +1,37 @@
+package util
+
+import (
+	"fmt"
+	"strings"
+
+	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+
+	svcapitypes "github.com/aws-controllers-k8s/apigateway-controller/apis/v1alpha1"
+)
+
+// ChangedFields returns the field paths in fieldPaths that differ in delta.
+func ChangedFields(delta *ackcompare.Delta, fieldPaths ...string) []string {
+	var changed []string
+	for _, fieldPath := range fieldPaths {
+		if delta.DifferentAt(fieldPath) {
+			changed = append(changed, fieldPath)
+		}
+	}
+	return changed
+}
+
+// RecreateRequested returns true if the replacement policy asks for the AWS
+// resource to be recreated when a field that cannot be updated in place changes.
+func RecreateRequested(replacementPolicy *string) bool {
+	return replacementPolicy != nil && *replacementPolicy == string(svcapitypes.ReplacementPolicy_Recreate)
+}
+
+// ImmutableFieldsChangedError returns a terminal error for changes to fields
+// that cannot be updated in place when recreation was not requested.
+func ImmutableFieldsChangedError(fieldPaths []string) error {
+	return ackerr.NewTerminalError(fmt.Errorf(
+		"%s cannot be updated in place, set spec.replacementPolicy to %s to replace the resource",
+		strings.Join(fieldPaths, ", "), svcapitypes.ReplacementPolicy_Recreate,
+	))
+}
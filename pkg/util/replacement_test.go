@@ -0,0 +1,32 @@
+package util_test
+
+import (
+	"testing"
+
+	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/aws-controllers-k8s/apigateway-controller/pkg/util"
+)
+
+func TestChangedFields(t *testing.T) {
+	delta := ackcompare.NewDelta()
+	delta.Add("Spec.Type", aws.String("HTTP"), aws.String("AWS"))
+	delta.Add("Spec.URI", aws.String("a"), aws.String("b"))
+
+	assert.Equal(t, []string{"Spec.Type"}, util.ChangedFields(delta, "Spec.Type", "Spec.ConnectionID"))
+	assert.Empty(t, util.ChangedFields(delta, "Spec.ConnectionID"))
+}
+
+func TestRecreateRequested(t *testing.T) {
+	assert.False(t, util.RecreateRequested(nil))
+	assert.False(t, util.RecreateRequested(aws.String("Retain")))
+	assert.True(t, util.RecreateRequested(aws.String("Recreate")))
+}
+
+func TestImmutableFieldsChangedError(t *testing.T) {
+	err := util.ImmutableFieldsChangedError([]string{"Spec.CloneFrom", "Spec.Version"})
+	assert.ErrorContains(t, err, "Spec.CloneFrom, Spec.Version cannot be updated in place")
+	assert.ErrorContains(t, err, "spec.replacementPolicy to Recreate")
+}
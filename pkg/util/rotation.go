@@ -0,0 +1,79 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package util
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	svcapitypes "github.com/aws-controllers-k8s/apigateway-controller/apis/v1alpha1"
+)
+
+// AnnotationRotate is the annotation whose value, when changed, requests the
+// rotation of an API key with a spec.rotation, e.g. the time of the request.
+const AnnotationRotate = "apigateway.services.k8s.aws/rotate"
+
+// DefaultRotationOverlap is how long the previous key of an API key keeps
+// working after a rotation when spec.rotation.overlap is not set.
+const DefaultRotationOverlap = 24 * time.Hour
+
+// InitAPIKeyRotation sets the rotation status of an API key with a
+// spec.rotation that has none, as if the key was rotated when it was created
+// with the current value of the rotate annotation.
+func InitAPIKeyRotation(ko *svcapitypes.APIKey, now time.Time) {
+	if ko.Spec.Rotation == nil || ko.Status.RotationStatus != nil {
+		return
+	}
+	last := ko.Status.CreatedDate
+	if last == nil {
+		last = &metav1.Time{Time: now}
+	}
+	ko.Status.RotationStatus = &svcapitypes.APIKeyRotationStatus{LastRotationTime: last}
+	if v, ok := ko.Annotations[AnnotationRotate]; ok {
+		ko.Status.RotationStatus.LastRequest = aws.String(v)
+	}
+}
+
+// APIKeyRotationDue returns whether an API key is due for rotation, because
+// its rotation interval has elapsed or the rotate annotation changed.
+func APIKeyRotationDue(ko *svcapitypes.APIKey, now time.Time) bool {
+	rotation, status := ko.Spec.Rotation, ko.Status.RotationStatus
+	if rotation == nil || status == nil || ko.Status.ID == nil {
+		return false
+	}
+	if v, ok := ko.Annotations[AnnotationRotate]; ok && v != aws.ToString(status.LastRequest) {
+		return true
+	}
+	return rotation.Interval != nil && status.LastRotationTime != nil &&
+		!now.Before(status.LastRotationTime.Add(rotation.Interval.Duration))
+}
+
+// APIKeyPreviousExpired returns whether the overlap period of the previous key
+// of an API key has ended.
+func APIKeyPreviousExpired(ko *svcapitypes.APIKey, now time.Time) bool {
+	status := ko.Status.RotationStatus
+	return status != nil && status.PreviousID != nil &&
+		(status.PreviousExpirationTime == nil || !now.Before(status.PreviousExpirationTime.Time))
+}
+
+// APIKeyRotationOverlap returns how long the previous key of an API key keeps
+// working after a rotation.
+func APIKeyRotationOverlap(ko *svcapitypes.APIKey) time.Duration {
+	if ko.Spec.Rotation == nil || ko.Spec.Rotation.Overlap == nil {
+		return DefaultRotationOverlap
+	}
+	return ko.Spec.Rotation.Overlap.Duration
+}
@@ -0,0 +1,66 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package util_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	svcapitypes "github.com/aws-controllers-k8s/apigateway-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/apigateway-controller/pkg/util"
+)
+
+func TestAPIKeyRotationDue(t *testing.T) {
+	created := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	ko := &svcapitypes.APIKey{
+		ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{util.AnnotationRotate: "a"}},
+		Spec: svcapitypes.APIKeySpec{Rotation: &svcapitypes.APIKeyRotation{
+			Interval: &metav1.Duration{Duration: 90 * 24 * time.Hour},
+		}},
+		Status: svcapitypes.APIKeyStatus{ID: aws.String("key1"), CreatedDate: &metav1.Time{Time: created}},
+	}
+	assert.False(t, util.APIKeyRotationDue(ko, created.Add(100*24*time.Hour)))
+
+	// The rotation state starts at the creation of the key, with the current
+	// rotation request.
+	util.InitAPIKeyRotation(ko, created.Add(time.Hour))
+	assert.Equal(t, created, ko.Status.RotationStatus.LastRotationTime.Time)
+	assert.Equal(t, "a", *ko.Status.RotationStatus.LastRequest)
+	assert.False(t, util.APIKeyRotationDue(ko, created.Add(89*24*time.Hour)))
+	assert.True(t, util.APIKeyRotationDue(ko, created.Add(90*24*time.Hour)))
+
+	ko.Annotations[util.AnnotationRotate] = "b"
+	assert.True(t, util.APIKeyRotationDue(ko, created))
+
+	ko.Spec.Rotation = nil
+	assert.False(t, util.APIKeyRotationDue(ko, created))
+	assert.Equal(t, util.DefaultRotationOverlap, util.APIKeyRotationOverlap(ko))
+}
+
+func TestAPIKeyPreviousExpired(t *testing.T) {
+	now := time.Now()
+	ko := &svcapitypes.APIKey{Status: svcapitypes.APIKeyStatus{RotationStatus: &svcapitypes.APIKeyRotationStatus{
+		PreviousID:             aws.String("key0"),
+		PreviousExpirationTime: &metav1.Time{Time: now},
+	}}}
+	assert.False(t, util.APIKeyPreviousExpired(ko, now.Add(-time.Second)))
+	assert.True(t, util.APIKeyPreviousExpired(ko, now))
+
+	ko.Status.RotationStatus.PreviousID = nil
+	assert.False(t, util.APIKeyPreviousExpired(ko, now))
+}
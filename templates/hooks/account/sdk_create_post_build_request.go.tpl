@@ -0,0 +1 @@
+    updateAccountInput(desired, input)
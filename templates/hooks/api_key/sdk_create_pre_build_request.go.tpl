@@ -0,0 +1,3 @@
+	if err := validateValue(desired.ko); err != nil {
+		return nil, err
+	}
@@ -0,0 +1,3 @@
+	if err := rm.deletePreviousAPIKey(ctx, r); err != nil {
+		return nil, err
+	}
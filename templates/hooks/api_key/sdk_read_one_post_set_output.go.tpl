@@ -0,0 +1,6 @@
+	if resp.StageKeys != nil {
+		ko.Spec.StageKeys = getStageKeysFromStrings(resp.StageKeys)
+	} else {
+		ko.Spec.StageKeys = nil
+	}
+	initRotation(ko)
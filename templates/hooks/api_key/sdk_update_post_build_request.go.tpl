@@ -1,2 +1,2 @@
 
-    updateApiKeyInput(desired, input, delta)
+    updateApiKeyInput(desired, latest, input, delta)
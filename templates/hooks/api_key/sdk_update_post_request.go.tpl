@@ -1,7 +0,0 @@
-	
-	if resp.StageKeys != nil {
-		desired.ko.Spec.StageKeys = getStageKeysFromStrings(resp.StageKeys)
-	} else {
-		desired.ko.Spec.StageKeys = nil
-	} 
-	
\ No newline at end of file
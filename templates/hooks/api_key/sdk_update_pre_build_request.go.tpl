@@ -1,3 +1,21 @@
+	if err := validateValue(desired.ko); err != nil {
+		return nil, err
+	}
+
+
+	// Rotate the key before the other updates, which then apply to the new key
+	if delta.DifferentAt("Spec.Rotation") {
+		if err := rm.rotateAPIKey(ctx, desired, latest); err != nil {
+			return desired, err
+		}
+		// desired holds the new key and the rotation status, which are kept
+		// should the other updates fail.
+		defer func() {
+			if err != nil && updated == nil {
+				updated = desired
+			}
+		}()
+	}
 
 	// Handle tag updates separately through TagResource/UntagResource APIs
 	if delta.DifferentAt("Spec.Tags") {
@@ -6,6 +24,6 @@
 		}
 	}
 
-	if !delta.DifferentExcept("Spec.Tags") {
+	if !delta.DifferentExcept("Spec.Tags", "Spec.Rotation") {
 		return desired, nil
 	}
@@ -0,0 +1,7 @@
+	batch, err := rm.setImportBatch(ctx, desired, input)
+	if err != nil {
+		return nil, err
+	}
+	if imported, err := rm.startImportBatch(ctx, desired, batch); err != nil || imported != nil {
+		return imported, err
+	}
@@ -0,0 +1,3 @@
+	if err := rm.recordImportBatch(ctx, ko, batch, resp); err != nil {
+		return &resource{ko}, err
+	}
@@ -0,0 +1,3 @@
+	if err := rm.syncCORS(ctx, &resource{ko}); err != nil {
+		return &resource{ko}, err
+	}
@@ -0,0 +1,3 @@
+	if err := validateAuthorizerSpec(desired); err != nil {
+		return nil, ackerr.NewTerminalError(err)
+	}
@@ -0,0 +1 @@
+    updateDocumentationPartInput(desired, input, delta)
@@ -0,0 +1 @@
+    updateDocumentationVersionInput(desired, input, delta)
@@ -0,0 +1 @@
+    updateGatewayResponseInput(desired, latest, input, delta)
@@ -0,0 +1,3 @@
+	if requiresReplacement(delta) {
+		return rm.replaceIntegration(ctx, desired, latest, delta)
+	}
@@ -0,0 +1,3 @@
+	if err == nil {
+		err = rm.syncCORS(ctx, r, true)
+	}
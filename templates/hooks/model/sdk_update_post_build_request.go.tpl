@@ -0,0 +1 @@
+    updateModelInput(desired, input, delta)
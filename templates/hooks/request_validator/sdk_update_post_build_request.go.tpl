@@ -0,0 +1 @@
+    updateRequestValidatorInput(desired, input, delta)
@@ -0,0 +1,5 @@
+	if ko.Spec.CORS != nil {
+		if err := rm.syncCORS(ctx, &resource{ko}); err != nil {
+			return &resource{ko}, err
+		}
+	}
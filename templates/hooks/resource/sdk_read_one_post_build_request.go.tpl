@@ -0,0 +1 @@
+	input.Embed = []string{"methods"}
@@ -0,0 +1 @@
+	setObservedCORS(ko, resp.ResourceMethods)
@@ -0,0 +1,8 @@
+	if delta.DifferentAt("Spec.CORS") {
+		if err := rm.syncCORS(ctx, desired); err != nil {
+			return nil, err
+		}
+	}
+	if !delta.DifferentExcept("Spec.CORS") {
+		return desired, nil
+	}
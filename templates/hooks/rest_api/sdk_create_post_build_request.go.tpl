@@ -0,0 +1,3 @@
+	if input.Policy, err = desiredPolicy(desired.ko); err != nil {
+		return nil, err
+	}
@@ -0,0 +1 @@
+	clearRenderedPolicy(ko)
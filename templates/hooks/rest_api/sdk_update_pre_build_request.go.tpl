@@ -1,3 +1,7 @@
+	if requiresReplacement(delta) {
+		return rm.replaceRestAPI(ctx, desired, latest, delta)
+	}
+
 	if delta.DifferentAt("Spec.Tags") {
 		resourceARN, err := arnForResource(desired.ko)
 		if err != nil {
@@ -0,0 +1,10 @@
+	if err := setResourceARN(ko); err != nil {
+		return nil, err
+	}
+	if replacedStage != nil {
+		// The new stage is returned even if deleting the old one fails so that
+		// the ARN of the new stage is recorded in the status.
+		if err := rm.deleteReplacedStage(ctx, ko.Spec.RestAPIID, replacedStage); err != nil {
+			return &resource{ko}, err
+		}
+	}
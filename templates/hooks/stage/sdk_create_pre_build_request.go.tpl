@@ -0,0 +1,4 @@
+	replacedStage, err := replacedStageName(desired)
+	if err != nil {
+		return nil, err
+	}
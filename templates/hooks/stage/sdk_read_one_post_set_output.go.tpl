@@ -0,0 +1,3 @@
+	if err := setResourceARN(ko); err != nil {
+		return nil, err
+	}
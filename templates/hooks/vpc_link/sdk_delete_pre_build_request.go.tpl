@@ -1,3 +1,6 @@
 	if err := validateDeleteState(r); err != nil {
 		return r, err
 	}
+	if err := rm.deleteVPCLink(ctx, r.ko.Status.ReplacedID); err != nil {
+		return r, err
+	}
@@ -1,3 +1,10 @@
+	if requiresReplacement(delta) {
+		return rm.replaceVPCLink(ctx, desired, latest, delta)
+	}
+	if latest.ko.Status.ReplacedID != nil {
+		return rm.completeReplacement(ctx, desired, latest)
+	}
+
 	if delta.DifferentAt("Spec.Tags") {
 		resourceARN, err := arnForResource(desired.ko)
 		if err != nil {
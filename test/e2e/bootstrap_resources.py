@@ -20,6 +20,7 @@ from acktest.bootstrapping import Resources
 from e2e import bootstrap_directory
 from acktest.bootstrapping.elbv2 import NetworkLoadBalancer
 from acktest.bootstrapping.cognito_identity import UserPool
+from acktest.bootstrapping.iam import Role
 
 
 @dataclass
@@ -27,6 +28,7 @@ class BootstrapResources(Resources):
     NetworkLoadBalancer: NetworkLoadBalancer
     AuthorizerUserPool1: UserPool
     AuthorizerUserPool2: UserPool
+    AccountCloudWatchRole: Role
 
 _bootstrap_resources = None
 
@@ -20,6 +20,7 @@ from e2e import bootstrap_directory
 from e2e.bootstrap_resources import BootstrapResources
 from acktest.bootstrapping.elbv2 import NetworkLoadBalancer
 from acktest.bootstrapping.cognito_identity import UserPool
+from acktest.bootstrapping.iam import Role
 
 
 def service_bootstrap() -> Resources:
@@ -33,6 +34,11 @@ def service_bootstrap() -> Resources:
             name_prefix='vpc-link-test', scheme='internal'),
         AuthorizerUserPool1=user_pool_1,
         AuthorizerUserPool2=user_pool_2,
+        AccountCloudWatchRole=Role(
+            name_prefix="ack-apigw-account-cw",
+            principal_service="apigateway.amazonaws.com",
+            managed_policies=["arn:aws:iam::aws:policy/service-role/AmazonAPIGatewayPushToCloudWatchLogs"],
+        ),
     )
 
     try:
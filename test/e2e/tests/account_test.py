@@ -0,0 +1,90 @@
+# Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the "License"). You may
+# not use this file except in compliance with the License. A copy of the
+# License is located at
+#
+#     http://aws.amazon.com/apache2.0/
+#
+# or in the "license" file accompanying this file. This file is distributed
+# on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+# express or implied. See the License for the specific language governing
+# permissions and limitations under the License.
+
+"""Integration tests for the Account resource
+"""
+
+import logging
+from typing import Tuple
+
+import boto3
+import pytest
+from acktest.k8s import resource as k8s
+from acktest.k8s import condition
+from acktest.resources import random_suffix_name
+from e2e import service_marker, CRD_GROUP, CRD_VERSION, SERVICE_NAME, load_apigateway_resource
+from e2e.bootstrap_resources import get_bootstrap_resources
+from e2e.replacement_values import REPLACEMENT_VALUES
+
+ACCOUNT_RESOURCE_PLURAL = "accounts"
+MAX_WAIT_FOR_SYNCED_MINUTES = 1
+
+
+@pytest.fixture(scope='module')
+def apigateway_client():
+    return boto3.client(SERVICE_NAME)
+
+
+@pytest.fixture
+def simple_account(apigateway_client) -> Tuple[k8s.CustomResourceReference, dict]:
+    account_name = random_suffix_name('account', 32)
+    role_arn = get_bootstrap_resources().AccountCloudWatchRole.arn
+
+    replacements = REPLACEMENT_VALUES.copy()
+    replacements['ACCOUNT_NAME'] = account_name
+    replacements['CLOUDWATCH_ROLE_ARN'] = role_arn
+
+    resource_data = load_apigateway_resource(
+        'account_simple',
+        additional_replacements=replacements,
+    )
+    logging.debug(resource_data)
+
+    # Accounts are cluster-scoped.
+    ref = k8s.CustomResourceReference(
+        CRD_GROUP, CRD_VERSION, ACCOUNT_RESOURCE_PLURAL,
+        account_name, namespace=None,
+    )
+
+    k8s.create_custom_resource(ref, resource_data)
+    cr = k8s.wait_resource_consumed_by_controller(ref, wait_periods=30)
+
+    assert cr is not None
+    assert k8s.get_resource_exists(ref)
+    k8s.wait_on_condition(
+        ref,
+        condition.CONDITION_TYPE_RESOURCE_SYNCED,
+        'True',
+        wait_periods=MAX_WAIT_FOR_SYNCED_MINUTES,
+    )
+
+    yield ref, k8s.get_resource(ref)
+
+    _, deleted = k8s.delete_custom_resource(ref, 10, 30)
+    assert deleted
+
+    # Deleting the Account leaves the account settings in place.
+    account = apigateway_client.get_account()
+    assert account['cloudwatchRoleArn'] == role_arn
+
+
+@service_marker
+@pytest.mark.canary
+class TestAccount:
+    def test_create_account(self, simple_account, apigateway_client):
+        (ref, cr) = simple_account
+
+        account = apigateway_client.get_account()
+        assert account['cloudwatchRoleArn'] == cr['spec']['cloudWatchRoleARN']
+        assert cr['status']['apiKeyVersion'] == account['apiKeyVersion']
+        assert cr['status']['throttleSettings']['burstLimit'] == account['throttleSettings']['burstLimit']
@@ -0,0 +1,106 @@
+# Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the "License"). You may
+# not use this file except in compliance with the License. A copy of the
+# License is located at
+#
+#     http://aws.amazon.com/apache2.0/
+#
+# or in the "license" file accompanying this file. This file is distributed
+# on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+# express or implied. See the License for the specific language governing
+# permissions and limitations under the License.
+
+"""Integration tests for the API Key Import resource
+"""
+
+import logging
+from typing import Dict, Tuple
+
+import pytest
+from acktest.k8s import resource as k8s
+from acktest.k8s import condition
+from acktest.resources import random_suffix_name
+from kubernetes import client as k8s_client
+from e2e import service_marker, CRD_GROUP, CRD_VERSION, load_apigateway_resource
+from e2e.replacement_values import REPLACEMENT_VALUES
+
+API_KEY_IMPORT_RESOURCE_PLURAL = "apikeyimports"
+MAX_WAIT_FOR_SYNCED_MINUTES = 2
+KEY_COUNT = 3
+
+
+@pytest.fixture(scope='module')
+def simple_api_key_import(apigateway_client) -> Tuple[k8s.CustomResourceReference, Dict]:
+    import_name = random_suffix_name("simple-api-key-import", 32)
+
+    rows = ["name,key,description,enabled"]
+    for i in range(KEY_COUNT):
+        rows.append(f"{import_name}-{i},{import_name}-value-{i},imported key,true")
+    secret = k8s_client.V1Secret(
+        metadata=k8s_client.V1ObjectMeta(name=import_name),
+        string_data={"keys.csv": "\n".join(rows) + "\n"},
+    )
+    k8s_client.CoreV1Api().create_namespaced_secret("default", secret)
+
+    replacements = REPLACEMENT_VALUES.copy()
+    replacements["API_KEY_IMPORT_NAME"] = import_name
+
+    resource_data = load_apigateway_resource(
+        "api_key_import_simple",
+        additional_replacements=replacements,
+    )
+    logging.debug(resource_data)
+
+    ref = k8s.CustomResourceReference(
+        CRD_GROUP,
+        CRD_VERSION,
+        API_KEY_IMPORT_RESOURCE_PLURAL,
+        import_name,
+        namespace="default",
+    )
+    k8s.create_custom_resource(ref, resource_data)
+    cr = k8s.wait_resource_consumed_by_controller(ref)
+
+    assert cr is not None
+    assert k8s.get_resource_exists(ref)
+    k8s.wait_on_condition(
+        ref,
+        condition.CONDITION_TYPE_RESOURCE_SYNCED,
+        "True",
+        wait_periods=MAX_WAIT_FOR_SYNCED_MINUTES,
+    )
+
+    cr = k8s.get_resource(ref)
+    yield ref, cr
+
+    _, deleted = k8s.delete_custom_resource(ref, 3, 10)
+    assert deleted
+    k8s_client.CoreV1Api().delete_namespaced_secret(import_name, "default")
+
+    # The imported keys are not deleted with the import.
+    for api_key_id in cr["status"].get("ids", []):
+        apigateway_client.delete_api_key(apiKey=api_key_id)
+
+
+@service_marker
+@pytest.mark.canary
+class TestAPIKeyImport:
+    def test_import_api_keys(self, simple_api_key_import, apigateway_client):
+        (ref, cr) = simple_api_key_import
+
+        status = cr["status"]
+        assert status["importStatus"] == "COMPLETED"
+        assert status["totalRows"] == KEY_COUNT
+        assert len(status["ids"]) == KEY_COUNT
+        assert status["importedRows"] == KEY_COUNT
+        assert "failedRows" not in status
+
+        names = {}
+        for api_key_id in status["ids"]:
+            aws_api_key = apigateway_client.get_api_key(apiKey=api_key_id, includeValue=True)
+            names[aws_api_key["name"]] = aws_api_key
+        for i in range(KEY_COUNT):
+            aws_api_key = names[f"{ref.name}-{i}"]
+            assert aws_api_key["value"] == f"{ref.name}-value-{i}"
+            assert aws_api_key["enabled"] == True
@@ -14,12 +14,14 @@
 """Integration tests for the API Key resource
 """
 
+import base64
 import pytest
 import logging
 import time
 from typing import Dict, Tuple
 
 from acktest.k8s import resource as k8s
+from kubernetes import client as k8s_client
 from acktest.resources import random_suffix_name
 from acktest import tags
 from e2e import service_marker, CRD_GROUP, CRD_VERSION, load_apigateway_resource
@@ -131,3 +133,19 @@ class TestAPIKey:
         )
 
         assert updated_aws_api_key["enabled"] == False
+
+    def test_generated_value_secret(self, simple_api_key, apigateway_client):
+        (ref, cr) = simple_api_key
+
+        aws_api_key = apigateway_client.get_api_key(
+            apiKey=cr["status"]["id"],
+            includeValue=True
+        )
+
+        secret = k8s_client.CoreV1Api().read_namespaced_secret(
+            cr["spec"]["generatedValueSecret"]["name"], "default"
+        )
+        assert base64.b64decode(secret.data["value"]).decode() == aws_api_key["value"]
+        owner = secret.metadata.owner_references[0]
+        assert owner.kind == "APIKey"
+        assert owner.uid == cr["metadata"]["uid"]
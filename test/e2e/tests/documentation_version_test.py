@@ -0,0 +1,102 @@
+# Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the "License"). You may
+# not use this file except in compliance with the License. A copy of the
+# License is located at
+#
+#     http://aws.amazon.com/apache2.0/
+#
+# or in the "license" file accompanying this file. This file is distributed
+# on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+# express or implied. See the License for the specific language governing
+# permissions and limitations under the License.
+"""Integration tests for the DocumentationVersion resource
+"""
+
+import logging
+import time
+from typing import Dict, Tuple
+from functools import partial
+
+import boto3
+import pytest
+from acktest.k8s import resource as k8s
+from acktest.k8s import condition
+from acktest.resources import random_suffix_name
+from e2e import service_marker, CRD_GROUP, CRD_VERSION, SERVICE_NAME, load_apigateway_resource
+from e2e.replacement_values import REPLACEMENT_VALUES
+from e2e.common.waiter import wait_until_deleted, safe_get
+from .rest_api_test import simple_rest_api
+
+DOCUMENTATION_VERSION_RESOURCE_PLURAL = "documentationversions"
+MODIFY_WAIT_AFTER_SECONDS = 60
+MAX_WAIT_FOR_SYNCED_MINUTES = 1
+
+
+@pytest.fixture(scope='module')
+def apigateway_client():
+    return boto3.client(SERVICE_NAME)
+
+
+@pytest.fixture
+def simple_documentation_version(simple_rest_api, apigateway_client) -> Tuple[k8s.CustomResourceReference, Dict, Dict]:
+    documentation_version_name = random_suffix_name('documentation-version', 32)
+    _, rest_api_cr = simple_rest_api
+
+    replacements = REPLACEMENT_VALUES.copy()
+    replacements['DOCUMENTATION_VERSION_NAME'] = documentation_version_name
+    replacements['REST_API_REF_NAME'] = rest_api_cr['metadata']['name']
+
+    resource_data = load_apigateway_resource(
+        'documentation_version_simple',
+        additional_replacements=replacements,
+    )
+    logging.debug(resource_data)
+
+    ref = k8s.CustomResourceReference(
+        CRD_GROUP, CRD_VERSION, DOCUMENTATION_VERSION_RESOURCE_PLURAL,
+        documentation_version_name, namespace='default',
+    )
+
+    k8s.create_custom_resource(ref, resource_data)
+    cr = k8s.wait_resource_consumed_by_controller(ref, wait_periods=30)
+
+    assert cr is not None
+    assert k8s.get_resource_exists(ref)
+    k8s.wait_on_condition(
+        ref,
+        condition.CONDITION_TYPE_RESOURCE_SYNCED,
+        'True',
+        wait_periods=MAX_WAIT_FOR_SYNCED_MINUTES,
+    )
+
+    documentation_version_query = {
+        'restApiId': rest_api_cr['status']['id'],
+        'documentationVersion': '1.0.0',
+    }
+
+    yield ref, cr, documentation_version_query
+
+    _, deleted = k8s.delete_custom_resource(ref, 10, 30)
+    assert deleted
+    wait_until_deleted(partial(
+        apigateway_client.get_documentation_version, **documentation_version_query))
+
+
+@service_marker
+@pytest.mark.canary
+class TestDocumentationVersion:
+    def test_create_update_documentation_version(self, simple_documentation_version, apigateway_client):
+        (ref, cr, documentation_version_query) = simple_documentation_version
+        get_documentation_version = partial(
+            apigateway_client.get_documentation_version, **documentation_version_query)
+
+        documentation_version = safe_get(get_documentation_version)
+        assert documentation_version is not None
+        assert documentation_version['description'] == 'Integration test'
+
+        k8s.patch_custom_resource(ref, {'spec': {'description': 'Updated description'}})
+        time.sleep(MODIFY_WAIT_AFTER_SECONDS)
+
+        documentation_version = get_documentation_version()
+        assert documentation_version['description'] == 'Updated description'
@@ -0,0 +1,118 @@
+# Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the "License"). You may
+# not use this file except in compliance with the License. A copy of the
+# License is located at
+#
+#     http://aws.amazon.com/apache2.0/
+#
+# or in the "license" file accompanying this file. This file is distributed
+# on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+# express or implied. See the License for the specific language governing
+# permissions and limitations under the License.
+
+"""Integration tests for the GatewayResponse resource
+"""
+
+import logging
+import time
+from typing import Dict, Tuple
+from functools import partial
+
+import boto3
+import pytest
+from acktest.k8s import resource as k8s
+from acktest.k8s import condition
+from acktest.resources import random_suffix_name
+from e2e import service_marker, CRD_GROUP, CRD_VERSION, SERVICE_NAME, load_apigateway_resource
+from e2e.replacement_values import REPLACEMENT_VALUES
+from e2e.common.waiter import safe_get
+from .rest_api_test import simple_rest_api
+
+GATEWAY_RESPONSE_RESOURCE_PLURAL = "gatewayresponses"
+MODIFY_WAIT_AFTER_SECONDS = 60
+MAX_WAIT_FOR_SYNCED_MINUTES = 1
+
+
+@pytest.fixture(scope='module')
+def apigateway_client():
+    return boto3.client(SERVICE_NAME)
+
+
+@pytest.fixture
+def simple_gateway_response(simple_rest_api, apigateway_client) -> Tuple[k8s.CustomResourceReference, Dict, Dict]:
+    gateway_response_name = random_suffix_name('gateway-response', 32)
+    _, rest_api_cr = simple_rest_api
+
+    replacements = REPLACEMENT_VALUES.copy()
+    replacements['GATEWAY_RESPONSE_NAME'] = gateway_response_name
+    replacements['REST_API_REF_NAME'] = rest_api_cr['metadata']['name']
+
+    resource_data = load_apigateway_resource(
+        'gateway_response_simple',
+        additional_replacements=replacements,
+    )
+    logging.debug(resource_data)
+
+    ref = k8s.CustomResourceReference(
+        CRD_GROUP, CRD_VERSION, GATEWAY_RESPONSE_RESOURCE_PLURAL,
+        gateway_response_name, namespace='default',
+    )
+
+    k8s.create_custom_resource(ref, resource_data)
+    cr = k8s.wait_resource_consumed_by_controller(ref, wait_periods=30)
+
+    assert cr is not None
+    assert k8s.get_resource_exists(ref)
+    k8s.wait_on_condition(
+        ref,
+        condition.CONDITION_TYPE_RESOURCE_SYNCED,
+        'True',
+        wait_periods=MAX_WAIT_FOR_SYNCED_MINUTES,
+    )
+
+    gateway_response_query = {
+        'restApiId': rest_api_cr['status']['id'],
+        'responseType': 'UNAUTHORIZED',
+    }
+
+    yield ref, cr, gateway_response_query
+
+    _, deleted = k8s.delete_custom_resource(ref, 10, 30)
+    assert deleted
+
+    # Deleting a gateway response resets it to the default response.
+    gateway_response = apigateway_client.get_gateway_response(**gateway_response_query)
+    assert gateway_response['defaultResponse'] is True
+
+
+@service_marker
+@pytest.mark.canary
+class TestGatewayResponse:
+    def test_create_update_gateway_response(self, simple_gateway_response, apigateway_client):
+        (ref, cr, gateway_response_query) = simple_gateway_response
+        get_gateway_response = partial(
+            apigateway_client.get_gateway_response, **gateway_response_query)
+
+        gateway_response = safe_get(get_gateway_response)
+        assert gateway_response is not None
+        assert gateway_response['defaultResponse'] is False
+        assert gateway_response['statusCode'] == '401'
+
+        updates = {
+            'statusCode': '403',
+            'responseParameters': {
+                'gatewayresponse.header.Access-Control-Allow-Origin': '\'https://example.com\'',
+                'gatewayresponse.header.Access-Control-Allow-Credentials': '\'true\''
+            },
+            'responseTemplates': {
+                'application/json': '{"error": {"message": $context.error.messageString, "requestId": "$context.requestId"}}'
+            }
+        }
+        k8s.patch_custom_resource(ref, {'spec': updates})
+        time.sleep(MODIFY_WAIT_AFTER_SECONDS)
+
+        gateway_response = get_gateway_response()
+        updated_fields = {field: gateway_response[field]
+                          for field in updates.keys()}
+        assert updated_fields == updates
@@ -0,0 +1,114 @@
+# Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the "License"). You may
+# not use this file except in compliance with the License. A copy of the
+# License is located at
+#
+#     http://aws.amazon.com/apache2.0/
+#
+# or in the "license" file accompanying this file. This file is distributed
+# on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+# express or implied. See the License for the specific language governing
+# permissions and limitations under the License.
+"""Integration tests for the RequestValidator resource
+"""
+
+import logging
+import time
+from typing import Dict, Tuple
+from functools import partial
+
+import boto3
+import pytest
+from acktest.k8s import resource as k8s
+from acktest.k8s import condition
+from acktest.resources import random_suffix_name
+from e2e import service_marker, CRD_GROUP, CRD_VERSION, SERVICE_NAME, load_apigateway_resource
+from e2e.replacement_values import REPLACEMENT_VALUES
+from e2e.common.waiter import wait_until_deleted, safe_get
+from .rest_api_test import simple_rest_api
+
+REQUEST_VALIDATOR_RESOURCE_PLURAL = "requestvalidators"
+MODIFY_WAIT_AFTER_SECONDS = 60
+MAX_WAIT_FOR_SYNCED_MINUTES = 1
+
+
+@pytest.fixture(scope='module')
+def apigateway_client():
+    return boto3.client(SERVICE_NAME)
+
+
+@pytest.fixture
+def simple_request_validator(simple_rest_api, apigateway_client) -> Tuple[k8s.CustomResourceReference, Dict, Dict]:
+    request_validator_name = random_suffix_name('request-validator', 32)
+    _, rest_api_cr = simple_rest_api
+
+    replacements = REPLACEMENT_VALUES.copy()
+    replacements['REQUEST_VALIDATOR_NAME'] = request_validator_name
+    replacements['REST_API_REF_NAME'] = rest_api_cr['metadata']['name']
+
+    resource_data = load_apigateway_resource(
+        'request_validator_simple',
+        additional_replacements=replacements,
+    )
+    logging.debug(resource_data)
+
+    ref = k8s.CustomResourceReference(
+        CRD_GROUP, CRD_VERSION, REQUEST_VALIDATOR_RESOURCE_PLURAL,
+        request_validator_name, namespace='default',
+    )
+
+    k8s.create_custom_resource(ref, resource_data)
+    cr = k8s.wait_resource_consumed_by_controller(ref, wait_periods=30)
+
+    assert cr is not None
+    assert k8s.get_resource_exists(ref)
+    k8s.wait_on_condition(
+        ref,
+        condition.CONDITION_TYPE_RESOURCE_SYNCED,
+        'True',
+        wait_periods=MAX_WAIT_FOR_SYNCED_MINUTES,
+    )
+    cr = k8s.get_resource(ref)
+
+    request_validator_query = {
+        'restApiId': rest_api_cr['status']['id'],
+        'requestValidatorId': cr['status']['id'],
+    }
+
+    yield ref, cr, request_validator_query
+
+    _, deleted = k8s.delete_custom_resource(ref, 10, 30)
+    assert deleted
+    wait_until_deleted(partial(
+        apigateway_client.get_request_validator, **request_validator_query))
+
+
+@service_marker
+@pytest.mark.canary
+class TestRequestValidator:
+    def test_create_update_request_validator(self, simple_request_validator, apigateway_client):
+        (ref, cr, request_validator_query) = simple_request_validator
+        get_request_validator = partial(
+            apigateway_client.get_request_validator, **request_validator_query)
+
+        request_validator = safe_get(get_request_validator)
+        assert request_validator is not None
+        assert request_validator['validateRequestBody']
+        assert not request_validator['validateRequestParameters']
+
+        k8s.patch_custom_resource(ref, {'spec': {
+            'validateRequestBody': False,
+            'validateRequestParameters': True,
+        }})
+        time.sleep(MODIFY_WAIT_AFTER_SECONDS)
+
+        request_validator = get_request_validator()
+        assert not request_validator['validateRequestBody']
+        assert request_validator['validateRequestParameters']
+        assert k8s.wait_on_condition(
+            ref,
+            condition.CONDITION_TYPE_RESOURCE_SYNCED,
+            'True',
+            wait_periods=MAX_WAIT_FOR_SYNCED_MINUTES,
+        )